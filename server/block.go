@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/cdrpl/granny/server/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Return the users that the user has blocked or has been blocked by.
+// The relations of online users are cached until they go offline or their blocks change.
+func (h *SocialHub) blockRelations(userID int) (map[int]bool, error) {
+	h.mut.Lock()
+	blocks, ok := h.blocks[userID]
+	gen := h.blockGen
+	h.mut.Unlock()
+
+	if ok {
+		return blocks, nil
+	}
+
+	ids, err := findBlockRelations(userID, h.pg)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks = make(map[int]bool, len(ids))
+	for _, id := range ids {
+		blocks[id] = true
+	}
+
+	// Relations that were dropped while they were loading may be out of date and are not cached
+	h.mut.Lock()
+	if h.sessions[userID] > 0 && h.blockGen == gen {
+		h.blocks[userID] = blocks
+	}
+	h.mut.Unlock()
+
+	return blocks, nil
+}
+
+// Check if either user has blocked the other.
+func (h *SocialHub) isBlocked(userID, otherID int) (bool, error) {
+	blocks, err := h.blockRelations(userID)
+	if err != nil {
+		return false, err
+	}
+	return blocks[otherID], nil
+}
+
+// Same as isBlocked but errors are logged and treated as not blocked, used when filtering what a user sees.
+func (h *SocialHub) hides(userID, otherID int) bool {
+	blocked, err := h.isBlocked(userID, otherID)
+	if err != nil {
+		log.Println("block relations error:", err)
+	}
+	return blocked
+}
+
+// Drop the cached block relations of the user.
+func (h *SocialHub) dropBlocks(userID int) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	delete(h.blocks, userID)
+	h.blockGen++
+}
+
+// Let every instance know the block relations of the users have changed.
+func (h *SocialHub) publishBlocksChanged(userIDs ...int) {
+	for _, id := range userIDs {
+		if err := h.rdb.Publish(context.Background(), blocksChanged, id).Err(); err != nil {
+			log.Println("publish blocks changed error:", err)
+		}
+	}
+}
+
+// BlockUser will block a user. Blocked users can not message, friend or play with the user and their chat is hidden.
+func (s *Server) BlockUser(ctx context.Context, in *proto.BlockUserReq) (*proto.BlockUserRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	blockedID, err := s.resolveUser(in.GetUserId(), in.GetName())
+	if err != nil {
+		return nil, err
+	} else if blockedID == id {
+		return nil, status.Error(codes.InvalidArgument, "can not block yourself")
+	}
+
+	if _, err := findUser(blockedID, s.pg); err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	if err := insertBlock(id, blockedID, s.pg); err != nil {
+		log.Println("block user error:", err)
+		return nil, status.Error(codes.Internal, "block user error")
+	}
+
+	s.social.publishBlocksChanged(id, blockedID)
+	s.social.publishFriendsChanged(id, blockedID)
+	return &proto.BlockUserRes{}, nil
+}
+
+// UnblockUser will unblock a user.
+func (s *Server) UnblockUser(ctx context.Context, in *proto.UnblockUserReq) (*proto.UnblockUserRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	unblocked, err := deleteBlock(id, int(in.UserId), s.pg)
+	if err != nil {
+		log.Println("unblock user error:", err)
+		return nil, status.Error(codes.Internal, "unblock user error")
+	} else if !unblocked {
+		return nil, status.Error(codes.NotFound, "user is not blocked")
+	}
+
+	s.social.publishBlocksChanged(id, int(in.UserId))
+	return &proto.UnblockUserRes{}, nil
+}
+
+// ListBlocked will return the users the user has blocked.
+func (s *Server) ListBlocked(ctx context.Context, in *proto.ListBlockedReq) (*proto.ListBlockedRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	users, err := findBlocked(id, s.pg)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "list blocked error")
+	}
+
+	return &proto.ListBlockedRes{Users: users}, nil
+}
+
+// Return an error if either user has blocked the other.
+func (s *Server) checkNotBlocked(userID, otherID int) error {
+	blocked, err := s.social.isBlocked(userID, otherID)
+	if err != nil {
+		log.Println("block relations error:", err)
+		return status.Error(codes.Internal, "block check error")
+	} else if blocked {
+		return status.Error(codes.PermissionDenied, "user is not available")
+	}
+	return nil
+}
+
+// Check if the room event should be hidden from the user. Chat from users that are blocked either way is hidden.
+func (s *Server) hideRoomEvent(userID int, event *proto.RoomEvent) bool {
+	msg := event.GetChatMessage()
+	return msg != nil && s.social.hides(userID, int(msg.Sender.Id))
+}
+
+// Remove chat from users that are blocked either way.
+func (s *Server) visibleChat(userID int, messages []*proto.ChatMessage) []*proto.ChatMessage {
+	visible := make([]*proto.ChatMessage, 0, len(messages))
+	for _, msg := range messages {
+		if !s.social.hides(userID, int(msg.Sender.Id)) {
+			visible = append(visible, msg)
+		}
+	}
+	return visible
+}
+
+// Return the IDs of the rooms that have a user in them that the user has blocked or has been blocked by.
+// Matchmaking does not place the user in these rooms.
+func (s *Server) blockedRooms(userID int) (map[string]bool, error) {
+	blocks, err := s.social.blockRelations(userID)
+	if err != nil || len(blocks) == 0 {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(blocks))
+	for id := range blocks {
+		ids = append(ids, id)
+	}
+
+	return s.dir.usersRooms(ids)
+}
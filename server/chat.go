@@ -0,0 +1,543 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cdrpl/granny/server/proto"
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Redis keys used by chat channels.
+const (
+	chatChannelPrefix = "chat-channel:"   // chat-channel:<name> is the pub/sub channel for channel messages
+	chatSubsPrefix    = "chat-subs:"      // chat-subs:<user id> is the set of channels the user has joined
+	chatSubsChanged   = "chat-subs"       // Pub/sub channel that receives a user ID when their joined channels change
+	directMessages    = "direct-messages" // Pub/sub channel for direct messages
+)
+
+// ChatChannel describes a named chat channel.
+type ChatChannel struct {
+	Name          string
+	ModeratorOnly bool
+}
+
+// Every chat channel, users join the global channel by default.
+var chatChannels = []ChatChannel{
+	{Name: "global"},
+	{Name: "trade"},
+	{Name: "help"},
+	{Name: "lang-en"},
+	{Name: "lang-es"},
+	{Name: "lang-fr"},
+	{Name: "lang-de"},
+	{Name: "lang-pt"},
+	{Name: "lang-ru"},
+	{Name: "lang-zh"},
+	{Name: "lang-ja"},
+	{Name: "moderators", ModeratorOnly: true},
+}
+
+// Return the channel with the given name.
+func findChatChannel(name string) (ChatChannel, bool) {
+	for _, channel := range chatChannels {
+		if channel.Name == name {
+			return channel, true
+		}
+	}
+	return ChatChannel{}, false
+}
+
+// ChatHub delivers channel messages to the chat streams open on this instance.
+// Messages and joined channel changes are shared between instances with Redis pub/sub.
+type ChatHub struct {
+	rdb       *redis.Client
+	pg        *pgxpool.Pool
+	social    *SocialHub
+	sessions  map[int]*chatSession // User ID to the user's chat stream
+	inboxes   map[int]*inbox       // User ID to the user's direct message stream
+	limiter   *RateLimiter
+	dmLimiter *RateLimiter
+	mut       sync.Mutex
+}
+
+// chatSession is an open chat stream.
+type chatSession struct {
+	userID   int
+	channels map[string]bool
+	messages chan *proto.ChannelMessage // Closed when the session ends
+}
+
+func newChatHub(rdb *redis.Client, pg *pgxpool.Pool, social *SocialHub) *ChatHub {
+	return &ChatHub{
+		rdb:       rdb,
+		pg:        pg,
+		social:    social,
+		sessions:  make(map[int]*chatSession),
+		inboxes:   make(map[int]*inbox),
+		limiter:   newRateLimiter(chatRateLimit, chatRateWindow),
+		dmLimiter: newRateLimiter(chatRateLimit, chatRateWindow),
+	}
+}
+
+// Receive messages from every instance and deliver them to the local sessions.
+func (h *ChatHub) run() {
+	pubsub := h.rdb.PSubscribe(context.Background(), chatChannelPrefix+"*")
+	if err := pubsub.Subscribe(context.Background(), chatSubsChanged, directMessages); err != nil {
+		log.Fatalln("chat hub subscribe error:", err)
+	}
+
+	for msg := range pubsub.Channel() {
+		if msg.Channel == chatSubsChanged {
+			userID, _ := strconv.Atoi(msg.Payload)
+			h.reloadChannels(userID)
+			continue
+		} else if msg.Channel == directMessages {
+			dm := &proto.DirectMessage{}
+			if err := protobuf.Unmarshal([]byte(msg.Payload), dm); err != nil {
+				log.Println("direct message unmarshal error:", err)
+				continue
+			}
+
+			h.deliverDirect(dm)
+			continue
+		}
+
+		chatMsg := &proto.ChannelMessage{}
+		if err := protobuf.Unmarshal([]byte(msg.Payload), chatMsg); err != nil {
+			log.Println("channel message unmarshal error:", err)
+			continue
+		}
+
+		h.deliver(chatMsg)
+	}
+}
+
+// Send the message to every session that has joined the channel, sessions that are not keeping up are skipped.
+// Users that have blocked the sender or have been blocked by the sender do not receive the message.
+// Guild channel messages only go to current members, a removed member may still have the channel joined
+// if leaving it failed.
+func (h *ChatHub) deliver(msg *proto.ChannelMessage) {
+	h.mut.Lock()
+	sessions := make([]*chatSession, 0)
+	for _, session := range h.sessions {
+		if session.channels[msg.Channel] {
+			sessions = append(sessions, session)
+		}
+	}
+	h.mut.Unlock()
+
+	var members map[int]bool
+	if guildID, ok := guildChannelID(msg.Channel); ok && len(sessions) > 0 {
+		var err error
+		if members, err = findGuildMemberIDs(guildID, h.pg); err != nil {
+			log.Println("deliver guild message error:", err)
+			return
+		}
+	}
+
+	visible := make([]*chatSession, 0, len(sessions))
+	for _, session := range sessions {
+		if members != nil && !members[session.userID] {
+			continue
+		}
+		if !h.social.hides(session.userID, int(msg.Sender.Id)) {
+			visible = append(visible, session)
+		}
+	}
+
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	for _, session := range visible {
+		if h.sessions[session.userID] != session {
+			continue // Session was closed
+		}
+
+		select {
+		case session.messages <- msg:
+		default:
+		}
+	}
+}
+
+// Open a chat session for the user, an existing session for the user is closed.
+func (h *ChatHub) openSession(userID int) (*chatSession, error) {
+	channels, err := h.joinedChannels(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &chatSession{
+		userID:   userID,
+		channels: channels,
+		messages: make(chan *proto.ChannelMessage, chatStreamBuffer),
+	}
+
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	if old, ok := h.sessions[userID]; ok {
+		close(old.messages)
+	}
+	h.sessions[userID] = session
+
+	return session, nil
+}
+
+// Close the session if it is still the user's session.
+func (h *ChatHub) closeSession(session *chatSession) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	if h.sessions[session.userID] == session {
+		delete(h.sessions, session.userID)
+		close(session.messages)
+	}
+}
+
+// Refresh the joined channels of a local session after they changed on any instance.
+func (h *ChatHub) reloadChannels(userID int) {
+	channels, err := h.joinedChannels(userID)
+	if err != nil {
+		log.Println("reload chat channels error:", err)
+		return
+	}
+
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	if session, ok := h.sessions[userID]; ok {
+		session.channels = channels
+	}
+}
+
+// Return the channels the user has joined. Users that have never joined a channel are placed in the global channel.
+func (h *ChatHub) joinedChannels(userID int) (map[string]bool, error) {
+	key := chatSubsPrefix + strconv.Itoa(userID)
+
+	names, err := h.rdb.SMembers(context.Background(), key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		exists, err := h.rdb.Exists(context.Background(), key+":init").Result()
+		if err != nil {
+			return nil, err
+		}
+		if exists == 0 {
+			if err := h.joinChannel(userID, chatChannels[0].Name); err != nil {
+				return nil, err
+			}
+			names = []string{chatChannels[0].Name}
+		}
+	}
+
+	channels := make(map[string]bool)
+	for _, name := range names {
+		channels[name] = true
+	}
+
+	return channels, nil
+}
+
+// Add the channel to the user's joined channels.
+func (h *ChatHub) joinChannel(userID int, name string) error {
+	key := chatSubsPrefix + strconv.Itoa(userID)
+
+	_, err := h.rdb.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.SAdd(context.Background(), key, name)
+		pipe.Set(context.Background(), key+":init", 1, 0) // Users that leave every channel are not placed back in global
+		pipe.Publish(context.Background(), chatSubsChanged, userID)
+		return nil
+	})
+	return err
+}
+
+// Remove the channel from the user's joined channels.
+func (h *ChatHub) leaveChannel(userID int, name string) error {
+	_, err := h.rdb.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.SRem(context.Background(), chatSubsPrefix+strconv.Itoa(userID), name)
+		pipe.Publish(context.Background(), chatSubsChanged, userID)
+		return nil
+	})
+	return err
+}
+
+// Send the message to every instance.
+func (h *ChatHub) publish(msg *proto.ChannelMessage) error {
+	bytes, err := protobuf.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return h.rdb.Publish(context.Background(), chatChannelPrefix+msg.Channel, bytes).Err()
+}
+
+// ListChannels will return every channel the user can join.
+func (s *Server) ListChannels(ctx context.Context, in *proto.ListChannelsReq) (*proto.ListChannelsRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	user, err := findUser(id, s.pg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list channels error: %v", err)
+	}
+
+	joined, err := s.chat.joinedChannels(id)
+	if err != nil {
+		log.Println("list channels joined error:", err)
+		return nil, status.Error(codes.Internal, "list channels error")
+	}
+
+	res := &proto.ListChannelsRes{}
+	for _, channel := range chatChannels {
+		if channel.ModeratorOnly && !user.Moderator {
+			continue
+		}
+
+		res.Channels = append(res.Channels, &proto.ChannelInfo{
+			Name:          channel.Name,
+			ModeratorOnly: channel.ModeratorOnly,
+			Joined:        joined[channel.Name],
+		})
+	}
+
+	guildID, _, err := findGuildMember(id, s.pg)
+	if err != nil {
+		log.Println("list channels guild error:", err)
+		return nil, status.Error(codes.Internal, "list channels error")
+	} else if guildID != 0 {
+		name := guildChannel(guildID)
+		res.Channels = append(res.Channels, &proto.ChannelInfo{Name: name, Joined: joined[name]})
+	}
+
+	return res, nil
+}
+
+// JoinChannel will subscribe the user to a channel.
+func (s *Server) JoinChannel(ctx context.Context, in *proto.JoinChannelReq) (*proto.JoinChannelRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	if _, err := s.checkChannelAccess(id, in.Channel); err != nil {
+		return nil, err
+	}
+
+	if err := s.chat.joinChannel(id, in.Channel); err != nil {
+		log.Println("join channel error:", err)
+		return nil, status.Error(codes.Internal, "join channel error")
+	}
+
+	return &proto.JoinChannelRes{}, nil
+}
+
+// LeaveChannel will unsubscribe the user from a channel.
+func (s *Server) LeaveChannel(ctx context.Context, in *proto.LeaveChannelReq) (*proto.LeaveChannelRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	if err := s.chat.leaveChannel(id, in.Channel); err != nil {
+		log.Println("leave channel error:", err)
+		return nil, status.Error(codes.Internal, "leave channel error")
+	}
+
+	return &proto.LeaveChannelRes{}, nil
+}
+
+// SendChannelMessage will save a message and send it to everyone that has joined the channel.
+func (s *Server) SendChannelMessage(ctx context.Context, in *proto.SendChannelMessageReq) (*proto.SendChannelMessageRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	if err := validateSendChannelMessageRequest(in); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := s.checkChannelAccess(id, in.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if user.isMuted() {
+		return nil, status.Error(codes.PermissionDenied, "you are muted")
+	}
+
+	joined, err := s.chat.joinedChannels(id)
+	if err != nil {
+		log.Println("send channel message joined error:", err)
+		return nil, status.Error(codes.Internal, "send channel message error")
+	} else if !joined[in.Channel] {
+		return nil, status.Error(codes.FailedPrecondition, "channel has not been joined")
+	}
+
+	if !s.chat.limiter.allow(id) {
+		return nil, status.Error(codes.ResourceExhausted, "sending messages too fast")
+	}
+
+	if in.Text, err = s.filterChat(id, in.Text); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	msgID, err := insertChannelMessage(in.Channel, id, in.Text, now, s.pg)
+	if err != nil {
+		log.Println("insert channel message error:", err)
+		return nil, status.Error(codes.Internal, "send channel message error")
+	}
+
+	msg := &proto.ChannelMessage{
+		Id:      msgID,
+		Channel: in.Channel,
+		Sender:  &proto.User{Id: int32(id), Name: user.Name},
+		Text:    in.Text,
+		Time:    now.UnixNano() / int64(time.Millisecond),
+	}
+
+	if err := s.chat.publish(msg); err != nil {
+		log.Println("publish channel message error:", err)
+		return nil, status.Error(codes.Internal, "send channel message error")
+	}
+
+	return &proto.SendChannelMessageRes{Message: msg}, nil
+}
+
+// GetChannelHistory will return a page of channel messages, newest message first.
+func (s *Server) GetChannelHistory(ctx context.Context, in *proto.GetChannelHistoryReq) (*proto.GetChannelHistoryRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	if _, err := s.checkChannelAccess(id, in.Channel); err != nil {
+		return nil, err
+	}
+
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = chatHistorySize
+	} else if limit > maxChatHistoryPage {
+		limit = maxChatHistoryPage
+	}
+
+	// Cursors are the ID of the oldest message on the previous page
+	beforeID := int64(math.MaxInt64)
+	if in.Cursor != "" {
+		var err error
+		beforeID, err = strconv.ParseInt(in.Cursor, 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	// Fetch one extra message to find out if there is another page
+	messages, err := findChannelMessages(in.Channel, beforeID, limit+1, s.pg)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "get channel history error")
+	}
+
+	res := &proto.GetChannelHistoryRes{Messages: messages}
+	if len(messages) > limit {
+		res.Messages = messages[:limit]
+		res.NextCursor = strconv.FormatInt(messages[limit-1].Id, 10)
+	}
+
+	return res, nil
+}
+
+// ChannelMessages streams the messages of every channel the user has joined.
+func (s *Server) ChannelMessages(req *proto.ChannelMessagesReq, stream proto.Chat_ChannelMessagesServer) error {
+	id, _, _ := extractUserIDAndToken(stream.Context())
+
+	session, err := s.chat.openSession(id)
+	if err != nil {
+		log.Println("open chat session error:", err)
+		return status.Error(codes.Internal, "channel messages error")
+	}
+	defer s.chat.closeSession(session)
+
+	for {
+		select {
+		case msg, ok := <-session.messages:
+			if !ok {
+				return nil // Another stream was opened
+			}
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// Check the channel exists and the user is allowed to use it.
+// Guild channels can only be used by members of the guild.
+func (s *Server) checkChannelAccess(userID int, name string) (User, error) {
+	if strings.HasPrefix(name, guildChannelPrefix) {
+		return s.checkGuildChannelAccess(userID, name)
+	}
+
+	channel, ok := findChatChannel(name)
+	if !ok {
+		return User{}, status.Error(codes.NotFound, "channel not found")
+	}
+
+	user, err := findUser(userID, s.pg)
+	if err != nil {
+		return user, status.Errorf(codes.Internal, "channel access error: %v", err)
+	}
+
+	if channel.ModeratorOnly && !user.Moderator {
+		return user, status.Error(codes.PermissionDenied, "channel is for moderators only")
+	}
+
+	return user, nil
+}
+
+// SendChannelMessageValidator is used to validate send channel message requests.
+type SendChannelMessageValidator struct {
+	Text string `valid:"required"`
+}
+
+// Sanitize and validate the send channel message request.
+func validateSendChannelMessageRequest(req *proto.SendChannelMessageReq) error {
+	req.Channel = strings.ToLower(govalidator.Trim(req.Channel, ""))
+	req.Text = govalidator.Trim(req.Text, "")
+
+	if utf8.RuneCountInString(req.Text) > maxChatLength {
+		return fmt.Errorf("text must be at most %d characters", maxChatLength)
+	}
+
+	v := SendChannelMessageValidator{Text: req.Text}
+	_, err := govalidator.ValidateStruct(v)
+	if err != nil {
+		return errors.New("text is required")
+	}
+	return nil
+}
+
+// Check the user is a member of the guild that owns the channel.
+func (s *Server) checkGuildChannelAccess(userID int, name string) (User, error) {
+	guildID, _, err := findGuildMember(userID, s.pg)
+	if err != nil {
+		return User{}, status.Errorf(codes.Internal, "channel access error: %v", err)
+	} else if guildID == 0 || guildChannel(guildID) != name {
+		return User{}, status.Error(codes.PermissionDenied, "channel is for guild members only")
+	}
+
+	user, err := findUser(userID, s.pg)
+	if err != nil {
+		return user, status.Errorf(codes.Internal, "channel access error: %v", err)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+// ColliderShape is the shape of a collider.
+type ColliderShape int
+
+// Collider shapes.
+const (
+	ColliderCircle ColliderShape = iota
+	ColliderBox                  // Axis aligned bounding box
+)
+
+// Collider is the space an entity takes up, centered on the entity's position.
+type Collider struct {
+	Shape    ColliderShape
+	Radius   float64 // Radius of a circle
+	HalfSize Vector  // Half the width and height of a box
+}
+
+// Collider of player entities.
+var playerCollider = Collider{Shape: ColliderCircle, Radius: playerRadius}
+
+// Collider of a map tile.
+var tileCollider = Collider{Shape: ColliderBox, HalfSize: Vector{X: tileSize / 2, Y: tileSize / 2}}
+
+// Contact is an overlap between two colliders.
+type Contact struct {
+	Normal Vector  // Direction to move the first collider to push it out of the second
+	Depth  float64 // Distance to move the first collider to push it out of the second
+}
+
+// Return the distance from the center to the furthest point of the collider.
+func (c Collider) reach() float64 {
+	if c.Shape == ColliderBox {
+		return c.HalfSize.magnitude()
+	}
+	return c.Radius
+}
+
+// Return the corners of the smallest box around the collider at the position.
+func (c Collider) bounds(pos Vector) (Vector, Vector) {
+	half := c.HalfSize
+	if c.Shape == ColliderCircle {
+		half = Vector{X: c.Radius, Y: c.Radius}
+	}
+	return vectorSub(pos, half), vectorAdd(pos, half)
+}
+
+// Check if the colliders at the positions overlap, colliders that only touch do not overlap.
+func collide(posA Vector, a Collider, posB Vector, b Collider) (Contact, bool) {
+	switch {
+	case a.Shape == ColliderCircle && b.Shape == ColliderCircle:
+		return circleCircle(posA, a.Radius, posB, b.Radius)
+	case a.Shape == ColliderCircle && b.Shape == ColliderBox:
+		return circleBox(posA, a.Radius, posB, b.HalfSize)
+	case a.Shape == ColliderBox && b.Shape == ColliderCircle:
+		contact, ok := circleBox(posB, b.Radius, posA, a.HalfSize)
+		contact.Normal = vectorMult(contact.Normal, -1)
+		return contact, ok
+	default:
+		return boxBox(posA, a.HalfSize, posB, b.HalfSize)
+	}
+}
+
+func circleCircle(a Vector, radiusA float64, b Vector, radiusB float64) (Contact, bool) {
+	diff := vectorSub(a, b)
+	dist := diff.magnitude()
+	if dist >= radiusA+radiusB {
+		return Contact{}, false
+	}
+
+	normal := diff.normalize()
+	if dist == 0 {
+		normal = Vector{X: 1} // Same position, any direction separates them
+	}
+	return Contact{Normal: normal, Depth: radiusA + radiusB - dist}, true
+}
+
+func circleBox(center Vector, radius float64, box, half Vector) (Contact, bool) {
+	// Closest point of the box to the center of the circle
+	closest := Vector{
+		X: math.Max(box.X-half.X, math.Min(center.X, box.X+half.X)),
+		Y: math.Max(box.Y-half.Y, math.Min(center.Y, box.Y+half.Y)),
+	}
+
+	diff := vectorSub(center, closest)
+	dist := diff.magnitude()
+	if dist > 0 {
+		if dist >= radius {
+			return Contact{}, false
+		}
+		return Contact{Normal: diff.normalize(), Depth: radius - dist}, true
+	}
+
+	// The center is inside the box, push out through the nearest side
+	dx, dy := center.X-box.X, center.Y-box.Y
+	overlapX := half.X - math.Abs(dx)
+	overlapY := half.Y - math.Abs(dy)
+	if overlapX <= overlapY {
+		return Contact{Normal: Vector{X: sideOf(dx)}, Depth: overlapX + radius}, true
+	}
+	return Contact{Normal: Vector{Y: sideOf(dy)}, Depth: overlapY + radius}, true
+}
+
+func boxBox(a, halfA, b, halfB Vector) (Contact, bool) {
+	dx, dy := a.X-b.X, a.Y-b.Y
+	overlapX := halfA.X + halfB.X - math.Abs(dx)
+	overlapY := halfA.Y + halfB.Y - math.Abs(dy)
+	if overlapX <= 0 || overlapY <= 0 {
+		return Contact{}, false
+	}
+
+	// Push out along the axis with the least overlap
+	if overlapX <= overlapY {
+		return Contact{Normal: Vector{X: sideOf(dx)}, Depth: overlapX}, true
+	}
+	return Contact{Normal: Vector{Y: sideOf(dy)}, Depth: overlapY}, true
+}
+
+// Return -1 if the value is negative, otherwise 1.
+func sideOf(v float64) float64 {
+	if v < 0 {
+		return -1
+	}
+	return 1
+}
+
+// Remove the part of the velocity going into a surface with the given normal so the entity slides along it.
+func slide(velocity, normal Vector) Vector {
+	if vectorDot(velocity, normal) >= 0 {
+		return velocity // Moving away from the surface
+	}
+	return vectorSub(velocity, vectorProject(velocity, normal))
+}
+
+// Push overlapping entities apart and then push entities out of terrain that can not be walked on, so entities
+// never end a tick inside rock or outside the map. Entities are resolved in ID order so every run gives the same result.
+func (sim *Simulation) resolveCollisions() {
+	ids := make([]int, 0, len(sim.entities))
+	for id := range sim.entities {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	for _, id := range ids {
+		entity := sim.entities[id]
+
+		// Any entity overlapping this one has its position within maxReach of this entity's bounds
+		min, max := entity.collider.bounds(entity.position)
+		reach := Vector{X: sim.maxReach, Y: sim.maxReach}
+		others := sim.grid.queryRect(vectorSub(min, reach), vectorAdd(max, reach))
+		sort.Ints(others)
+
+		for _, otherID := range others {
+			if otherID <= id {
+				continue // Each pair is only resolved once
+			}
+
+			other := sim.entities[otherID]
+			contact, ok := collide(entity.position, entity.collider, other.position, other.collider)
+			if !ok {
+				continue
+			}
+
+			// Both entities move half the overlap
+			push := vectorMult(contact.Normal, contact.Depth/2)
+			entity.position = vectorAdd(entity.position, push)
+			other.position = vectorSub(other.position, push)
+			entity.velocity = slide(entity.velocity, contact.Normal)
+			other.velocity = slide(other.velocity, vectorMult(contact.Normal, -1))
+
+			sim.grid.move(entity.id, entity.position)
+			sim.grid.move(other.id, other.position)
+		}
+	}
+
+	if sim.world == nil {
+		return
+	}
+
+	for _, id := range ids {
+		entity := sim.entities[id]
+		sim.resolveTerrain(entity)
+		sim.grid.move(entity.id, entity.position)
+	}
+}
+
+// Push the entity out of every tile it overlaps that can not be walked on, tiles outside the map count as rock.
+func (sim *Simulation) resolveTerrain(entity *Entity) {
+	min, max := entity.collider.bounds(entity.position)
+	minX, minY := sim.world.tileAt(min)
+	maxX, maxY := sim.world.tileAt(max)
+
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			if terrain, ok := sim.world.tile(x, y); ok && terrain.walkable() {
+				continue
+			}
+
+			contact, ok := collide(entity.position, entity.collider, sim.world.tileCenter(x, y), tileCollider)
+			if !ok {
+				continue
+			}
+
+			entity.position = vectorAdd(entity.position, vectorMult(contact.Normal, contact.Depth))
+			entity.velocity = slide(entity.velocity, contact.Normal)
+		}
+	}
+}
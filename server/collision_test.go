@@ -0,0 +1,226 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCollide(t *testing.T) {
+	circle := Collider{Shape: ColliderCircle, Radius: 1}
+	box := Collider{Shape: ColliderBox, HalfSize: Vector{X: 1, Y: 1}}
+	diag := math.Sqrt(0.5)
+
+	tests := []struct {
+		name   string
+		posA   Vector
+		a      Collider
+		posB   Vector
+		b      Collider
+		wantOK bool
+		want   Contact
+	}{
+		{"circles apart", Vector{X: 3}, circle, Vector{}, circle, false, Contact{}},
+		{"circles touching", Vector{X: 2}, circle, Vector{}, circle, false, Contact{}},
+		{"circles overlapping", Vector{X: 1.5}, circle, Vector{}, circle, true, Contact{Normal: Vector{X: 1}, Depth: 0.5}},
+		{"circles same position", Vector{}, circle, Vector{}, circle, true, Contact{Normal: Vector{X: 1}, Depth: 2}},
+
+		{"circle box apart", Vector{X: 3}, circle, Vector{}, box, false, Contact{}},
+		{"circle box touching side", Vector{X: 2}, circle, Vector{}, box, false, Contact{}},
+		{"circle box touching corner", Vector{X: 4, Y: 5}, Collider{Shape: ColliderCircle, Radius: 5}, Vector{}, box, false, Contact{}},
+		{"circle box overlapping side", Vector{X: 1.5}, circle, Vector{}, box, true, Contact{Normal: Vector{X: 1}, Depth: 0.5}},
+		{"circle box overlapping corner", Vector{X: 1.5, Y: 1.5}, circle, Vector{}, box, true,
+			Contact{Normal: Vector{X: diag, Y: diag}, Depth: 1 - diag}},
+		{"circle center on box edge", Vector{X: 1}, circle, Vector{}, box, true, Contact{Normal: Vector{X: 1}, Depth: 1}},
+		{"circle center inside near x side", Vector{X: 0.8, Y: 0.1}, circle, Vector{}, box, true,
+			Contact{Normal: Vector{X: 1}, Depth: 1.2}},
+		{"circle center inside near y side", Vector{X: 0.1, Y: -0.7}, circle, Vector{}, box, true,
+			Contact{Normal: Vector{Y: -1}, Depth: 1.3}},
+		{"circle center on box center", Vector{}, circle, Vector{}, box, true, Contact{Normal: Vector{X: 1}, Depth: 2}},
+
+		{"box circle overlapping", Vector{X: 1.5}, box, Vector{}, circle, true, Contact{Normal: Vector{X: 1}, Depth: 0.5}},
+		{"box circle touching", Vector{Y: -2}, box, Vector{}, circle, false, Contact{}},
+
+		{"boxes apart", Vector{X: 3}, box, Vector{}, box, false, Contact{}},
+		{"boxes touching side", Vector{X: 2, Y: 0.5}, box, Vector{}, box, false, Contact{}},
+		{"boxes touching corner", Vector{X: 2, Y: 2}, box, Vector{}, box, false, Contact{}},
+		{"boxes overlapping x", Vector{X: 1.5, Y: 0.5}, box, Vector{}, box, true, Contact{Normal: Vector{X: 1}, Depth: 0.5}},
+		{"boxes overlapping y", Vector{X: -0.5, Y: -1.8}, box, Vector{}, box, true, Contact{Normal: Vector{Y: -1}, Depth: 0.2}},
+		{"boxes same position", Vector{}, box, Vector{}, box, true, Contact{Normal: Vector{X: 1}, Depth: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := collide(tt.posA, tt.a, tt.posB, tt.b)
+			if ok != tt.wantOK {
+				t.Fatalf("collide() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !vectorApproxEqual(got.Normal, tt.want.Normal, epsilon) || math.Abs(got.Depth-tt.want.Depth) > epsilon {
+				t.Errorf("collide() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlide(t *testing.T) {
+	diag := math.Sqrt(0.5)
+
+	tests := []struct {
+		name     string
+		velocity Vector
+		normal   Vector
+		want     Vector
+	}{
+		{"into surface", Vector{X: -1, Y: 1}, Vector{X: 1}, Vector{Y: 1}},
+		{"head on", Vector{X: -2}, Vector{X: 1}, Vector{}},
+		{"away from surface", Vector{X: 1, Y: 1}, Vector{X: 1}, Vector{X: 1, Y: 1}},
+		{"along surface", Vector{Y: 3}, Vector{X: 1}, Vector{Y: 3}},
+		{"diagonal surface", Vector{X: -1}, Vector{X: diag, Y: diag}, Vector{X: -0.5, Y: 0.5}},
+		{"standing still", Vector{}, Vector{Y: -1}, Vector{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slide(tt.velocity, tt.normal); !vectorApproxEqual(got, tt.want, epsilon) {
+				t.Errorf("slide(%v, %v) = %v, want %v", tt.velocity, tt.normal, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveTerrain(t *testing.T) {
+	// One rock tile covering 0,0 to 2,2, the map covers -32,-32 to 32,32
+	world := newTileMap(1, 1)
+	world.setTile(16, 16, TerrainRock)
+	pushed := 2 + 0.5*math.Sqrt(0.5)
+
+	tests := []struct {
+		name         string
+		position     Vector
+		velocity     Vector
+		wantPosition Vector
+		wantVelocity Vector
+	}{
+		{"clear", Vector{X: -5, Y: -5}, Vector{X: 1}, Vector{X: -5, Y: -5}, Vector{X: 1}},
+		{"touching", Vector{X: -0.5, Y: 1}, Vector{X: 1}, Vector{X: -0.5, Y: 1}, Vector{X: 1}},
+		{"overlapping side", Vector{X: -0.25, Y: 1}, Vector{X: 1, Y: 1}, Vector{X: -0.5, Y: 1}, Vector{Y: 1}},
+		{"overlapping corner", Vector{X: 2.25, Y: 2.25}, Vector{X: -1, Y: -1}, Vector{X: pushed, Y: pushed}, Vector{}},
+		{"center inside", Vector{X: 0.3, Y: 1}, Vector{X: 1}, Vector{X: -0.5, Y: 1}, Vector{}},
+		{"map edge", Vector{X: 31.8}, Vector{X: 1, Y: -1}, Vector{X: 31.5}, Vector{Y: -1}},
+		{"map corner", Vector{X: -31.9, Y: -31.9}, Vector{}, Vector{X: -31.5, Y: -31.5}, Vector{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sim := newSimulation(defaultTickRate, world, nil)
+			entity := &Entity{id: 1, position: tt.position, velocity: tt.velocity, collider: playerCollider}
+			sim.resolveTerrain(entity)
+
+			if !vectorApproxEqual(entity.position, tt.wantPosition, epsilon) {
+				t.Errorf("position = %v, want %v", entity.position, tt.wantPosition)
+			}
+			if !vectorApproxEqual(entity.velocity, tt.wantVelocity, epsilon) {
+				t.Errorf("velocity = %v, want %v", entity.velocity, tt.wantVelocity)
+			}
+		})
+	}
+}
+
+// Return the deepest overlap between the entity and a rock tile or the outside of the map.
+func rockOverlap(sim *Simulation, entity *Entity) float64 {
+	min, max := entity.collider.bounds(entity.position)
+	minX, minY := sim.world.tileAt(min)
+	maxX, maxY := sim.world.tileAt(max)
+
+	deepest := 0.0
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			if terrain, ok := sim.world.tile(x, y); ok && terrain.walkable() {
+				continue
+			}
+			if contact, ok := collide(entity.position, entity.collider, sim.world.tileCenter(x, y), tileCollider); ok {
+				deepest = math.Max(deepest, contact.Depth)
+			}
+		}
+	}
+	return deepest
+}
+
+func TestSimulationWalkIntoRock(t *testing.T) {
+	// A wall of rock from 8 to 10 on the x axis
+	world := newTileMap(1, 1)
+	for y := 0; y < world.tileHeight(); y++ {
+		world.setTile(20, y, TerrainRock)
+	}
+
+	tests := []struct {
+		name     string
+		tickRate int
+		move     Vector
+		wantX    float64
+	}{
+		{"straight", defaultTickRate, Vector{X: 1}, 7.5},
+		{"diagonal", defaultTickRate, Vector{X: 1, Y: 1}, 7.5},
+		{"low tick rate", 1, Vector{X: 1}, 7.5},
+		{"high tick rate", maxTickRate, Vector{X: 1, Y: -1}, 7.5},
+		{"map edge", defaultTickRate, Vector{X: -1}, -31.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sim := newSimulation(tt.tickRate, world, []int{1})
+			entity := sim.entities[1]
+			entity.position = Vector{X: 1, Y: 1}
+			sim.grid.move(1, entity.position)
+			sim.queueInput(1, &PlayerInput{Seq: 1, Move: tt.move})
+
+			// Long enough to reach the wall and keep pushing into it
+			for i := 0; i < 10*tt.tickRate; i++ {
+				sim.step()
+				if depth := rockOverlap(sim, entity); depth > epsilon {
+					t.Fatalf("tick %v ended %v inside rock at %v", sim.tick, depth, entity.position)
+				}
+			}
+
+			if math.Abs(entity.position.X-tt.wantX) > epsilon {
+				t.Errorf("stopped at x %v, want %v", entity.position.X, tt.wantX)
+			}
+		})
+	}
+}
+
+func TestSimulationWalkIntoPlayer(t *testing.T) {
+	tests := []struct {
+		name         string
+		tickRate     int
+		moveA, moveB Vector
+		blocked      bool // Players meet head on and can not get past each other
+	}{
+		{"into standing player", defaultTickRate, Vector{X: 1}, Vector{}, true},
+		{"head on", defaultTickRate, Vector{X: 1}, Vector{X: -1}, true},
+		{"head on low tick rate", 1, Vector{X: 1}, Vector{X: -1}, true},
+		{"glancing", defaultTickRate, Vector{X: 1, Y: 0.1}, Vector{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sim := newSimulation(tt.tickRate, nil, []int{1, 2})
+			a, b := sim.entities[1], sim.entities[2]
+			a.position, b.position = Vector{X: -3}, Vector{X: 3}
+			sim.grid.move(1, a.position)
+			sim.grid.move(2, b.position)
+			sim.queueInput(1, &PlayerInput{Seq: 1, Move: tt.moveA})
+			sim.queueInput(2, &PlayerInput{Seq: 1, Move: tt.moveB})
+
+			for i := 0; i < 3*tt.tickRate; i++ {
+				sim.step()
+				if dist := vectorDist(a.position, b.position); dist < 2*playerRadius-epsilon {
+					t.Fatalf("tick %v ended with players %v apart, want at least %v", sim.tick, dist, 2*playerRadius)
+				}
+			}
+
+			if tt.blocked && a.position.X >= b.position.X {
+				t.Errorf("players passed through each other, a at %v and b at %v", a.position, b.position)
+			}
+		})
+	}
+}
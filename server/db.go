@@ -8,6 +8,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/cdrpl/granny/server/proto"
 	"github.com/go-redis/redis/v8"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
@@ -94,15 +95,40 @@ func dbUp(pg *pgxpool.Pool) error {
 func findUser(id int, pg *pgxpool.Pool) (User, error) {
 	user := User{ID: id}
 
-	sql := "SELECT name FROM users WHERE id = $1"
-	err := pg.QueryRow(context.Background(), sql, id).Scan(&user.Name)
+	var mutedUntil *time.Time
+	sql := "SELECT name, moderator, muted_until FROM users WHERE id = $1"
+	err := pg.QueryRow(context.Background(), sql, id).Scan(&user.Name, &user.Moderator, &mutedUntil)
 	if err != nil {
 		return user, fmt.Errorf("find user query row error: %v", err)
 	}
 
+	if mutedUntil != nil {
+		user.MutedUntil = *mutedUntil
+	}
+
 	return user, nil
 }
 
+// Mute the user until the given time.
+func muteUser(id int, until time.Time, pg *pgxpool.Pool) error {
+	_, err := pg.Exec(context.Background(), "UPDATE users SET muted_until = $2 WHERE id = $1", id, until)
+	return err
+}
+
+// Return the ID of the user with the given name, zero is returned if there is no such user.
+func findUserIDByName(name string, pg *pgxpool.Pool) (int, error) {
+	var id int
+
+	err := pg.QueryRow(context.Background(), "SELECT id FROM users WHERE name = $1", name).Scan(&id)
+	if err == pgx.ErrNoRows {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("find user id query row error: %v", err)
+	}
+
+	return id, nil
+}
+
 func userNameExists(name string, pg *pgxpool.Pool) (bool, error) {
 	var id int
 
@@ -134,3 +160,620 @@ func insertUser(user User, pg *pgxpool.Pool) error {
 	_, err := pg.Exec(context.Background(), sql, user.Name, user.Email, user.Pass, user.CreatedAt)
 	return err
 }
+
+// Insert a chat channel message and return its ID.
+func insertChannelMessage(channel string, senderID int, text string, createdAt time.Time, pg *pgxpool.Pool) (int64, error) {
+	var id int64
+
+	sql := "INSERT INTO chat_messages (channel, sender_id, text, created_at) VALUES ($1, $2, $3, $4) RETURNING id"
+	err := pg.QueryRow(context.Background(), sql, channel, senderID, text, createdAt).Scan(&id)
+	return id, err
+}
+
+// Return the messages of a chat channel that are older than the given message ID, newest message first.
+func findChannelMessages(channel string, beforeID int64, limit int, pg *pgxpool.Pool) ([]*proto.ChannelMessage, error) {
+	sql := `SELECT m.id, m.sender_id, u.name, m.text, m.created_at FROM chat_messages m
+		JOIN users u ON u.id = m.sender_id
+		WHERE m.channel = $1 AND m.id < $2 ORDER BY m.id DESC LIMIT $3`
+
+	rows, err := pg.Query(context.Background(), sql, channel, beforeID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("find channel messages query error: %v", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*proto.ChannelMessage, 0, limit)
+	for rows.Next() {
+		msg := &proto.ChannelMessage{Channel: channel, Sender: &proto.User{}}
+
+		var createdAt time.Time
+		err := rows.Scan(&msg.Id, &msg.Sender.Id, &msg.Sender.Name, &msg.Text, &createdAt)
+		if err != nil {
+			return nil, fmt.Errorf("find channel messages scan error: %v", err)
+		}
+
+		msg.Time = createdAt.UnixNano() / int64(time.Millisecond)
+		messages = append(messages, msg)
+	}
+
+	return messages, rows.Err()
+}
+
+// Return the IDs of the users that the user has blocked or has been blocked by.
+func findBlockRelations(userID int, pg *pgxpool.Pool) ([]int, error) {
+	sql := `SELECT blocked_id FROM user_blocks WHERE user_id = $1
+		UNION SELECT user_id FROM user_blocks WHERE blocked_id = $1`
+
+	rows, err := pg.Query(context.Background(), sql, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find block relations query error: %v", err)
+	}
+	defer rows.Close()
+
+	ids := make([]int, 0)
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("find block relations scan error: %v", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// Return the users the user has blocked ordered by name.
+func findBlocked(userID int, pg *pgxpool.Pool) ([]*proto.User, error) {
+	sql := `SELECT u.id, u.name FROM user_blocks b JOIN users u ON u.id = b.blocked_id
+		WHERE b.user_id = $1 ORDER BY u.name`
+	return queryUsers(sql, userID, pg)
+}
+
+// Block a user. Friendship and friend requests between the users are removed.
+func insertBlock(userID, blockedID int, pg *pgxpool.Pool) error {
+	tx, err := pg.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	sql := `INSERT INTO user_blocks (user_id, blocked_id, created_at) VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING`
+	if _, err := tx.Exec(context.Background(), sql, userID, blockedID, time.Now()); err != nil {
+		return err
+	}
+
+	sql = `DELETE FROM friends WHERE (user_id = $1 AND friend_id = $2) OR (user_id = $2 AND friend_id = $1)`
+	if _, err := tx.Exec(context.Background(), sql, userID, blockedID); err != nil {
+		return err
+	}
+
+	sql = `DELETE FROM friend_requests WHERE (sender_id = $1 AND recipient_id = $2) OR (sender_id = $2 AND recipient_id = $1)`
+	if _, err := tx.Exec(context.Background(), sql, userID, blockedID); err != nil {
+		return err
+	}
+
+	return tx.Commit(context.Background())
+}
+
+// Unblock a user, false is returned if the user was not blocked.
+func deleteBlock(userID, blockedID int, pg *pgxpool.Pool) (bool, error) {
+	sql := "DELETE FROM user_blocks WHERE user_id = $1 AND blocked_id = $2"
+	tag, err := pg.Exec(context.Background(), sql, userID, blockedID)
+	return tag.RowsAffected() == 1, err
+}
+
+// Insert a direct message and return its ID.
+func insertDirectMessage(senderID, recipientID int, text string, createdAt time.Time, pg *pgxpool.Pool) (int64, error) {
+	var id int64
+
+	sql := "INSERT INTO direct_messages (sender_id, recipient_id, text, created_at) VALUES ($1, $2, $3, $4) RETURNING id"
+	err := pg.QueryRow(context.Background(), sql, senderID, recipientID, text, createdAt).Scan(&id)
+	return id, err
+}
+
+// Return the unread direct messages sent to the user, oldest message first.
+func findUnreadDirectMessages(recipientID int, pg *pgxpool.Pool) ([]*proto.DirectMessage, error) {
+	sql := `SELECT m.id, m.sender_id, s.name, m.recipient_id, r.name, m.text, m.created_at FROM direct_messages m
+		JOIN users s ON s.id = m.sender_id
+		JOIN users r ON r.id = m.recipient_id
+		WHERE m.recipient_id = $1 AND m.read_at IS NULL ORDER BY m.id`
+
+	rows, err := pg.Query(context.Background(), sql, recipientID)
+	if err != nil {
+		return nil, fmt.Errorf("find unread direct messages query error: %v", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*proto.DirectMessage, 0)
+	for rows.Next() {
+		msg := &proto.DirectMessage{Sender: &proto.User{}, Recipient: &proto.User{}}
+
+		var createdAt time.Time
+		err := rows.Scan(&msg.Id, &msg.Sender.Id, &msg.Sender.Name, &msg.Recipient.Id, &msg.Recipient.Name, &msg.Text, &createdAt)
+		if err != nil {
+			return nil, fmt.Errorf("find unread direct messages scan error: %v", err)
+		}
+
+		msg.Time = createdAt.UnixNano() / int64(time.Millisecond)
+		messages = append(messages, msg)
+	}
+
+	return messages, rows.Err()
+}
+
+// Return the number of unread direct messages from each sender.
+func countUnreadDirectMessages(recipientID int, pg *pgxpool.Pool) ([]*proto.UnreadCount, error) {
+	sql := `SELECT m.sender_id, u.name, COUNT(*)::INTEGER FROM direct_messages m
+		JOIN users u ON u.id = m.sender_id
+		WHERE m.recipient_id = $1 AND m.read_at IS NULL GROUP BY m.sender_id, u.name ORDER BY MAX(m.id) DESC`
+
+	rows, err := pg.Query(context.Background(), sql, recipientID)
+	if err != nil {
+		return nil, fmt.Errorf("count unread direct messages query error: %v", err)
+	}
+	defer rows.Close()
+
+	counts := make([]*proto.UnreadCount, 0)
+	for rows.Next() {
+		count := &proto.UnreadCount{Sender: &proto.User{}}
+
+		err := rows.Scan(&count.Sender.Id, &count.Sender.Name, &count.Count)
+		if err != nil {
+			return nil, fmt.Errorf("count unread direct messages scan error: %v", err)
+		}
+
+		counts = append(counts, count)
+	}
+
+	return counts, rows.Err()
+}
+
+// Mark the direct messages from the sender up to the given message ID as read.
+func markDirectMessagesRead(recipientID, senderID int, upToID int64, pg *pgxpool.Pool) error {
+	sql := `UPDATE direct_messages SET read_at = $4
+		WHERE recipient_id = $1 AND sender_id = $2 AND id <= $3 AND read_at IS NULL`
+	_, err := pg.Exec(context.Background(), sql, recipientID, senderID, upToID, time.Now())
+	return err
+}
+
+// Return the user's friends ordered by name.
+func findFriends(userID int, pg *pgxpool.Pool) ([]*proto.User, error) {
+	sql := `SELECT u.id, u.name FROM friends f JOIN users u ON u.id = f.friend_id
+		WHERE f.user_id = $1 ORDER BY u.name`
+	return queryUsers(sql, userID, pg)
+}
+
+// Return the users that sent the user a friend request, oldest request first.
+func findIncomingFriendRequests(userID int, pg *pgxpool.Pool) ([]*proto.User, error) {
+	sql := `SELECT u.id, u.name FROM friend_requests r JOIN users u ON u.id = r.sender_id
+		WHERE r.recipient_id = $1 ORDER BY r.created_at`
+	return queryUsers(sql, userID, pg)
+}
+
+// Return the users that the user sent a friend request to, oldest request first.
+func findOutgoingFriendRequests(userID int, pg *pgxpool.Pool) ([]*proto.User, error) {
+	sql := `SELECT u.id, u.name FROM friend_requests r JOIN users u ON u.id = r.recipient_id
+		WHERE r.sender_id = $1 ORDER BY r.created_at`
+	return queryUsers(sql, userID, pg)
+}
+
+// Run a query that selects the ID and name of users.
+func queryUsers(sql string, arg interface{}, pg *pgxpool.Pool) ([]*proto.User, error) {
+	rows, err := pg.Query(context.Background(), sql, arg)
+	if err != nil {
+		return nil, fmt.Errorf("query users error: %v", err)
+	}
+	defer rows.Close()
+
+	users := make([]*proto.User, 0)
+	for rows.Next() {
+		user := &proto.User{}
+		if err := rows.Scan(&user.Id, &user.Name); err != nil {
+			return nil, fmt.Errorf("query users scan error: %v", err)
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+// Check if the users are friends.
+func areFriends(userID, otherID int, pg *pgxpool.Pool) (bool, error) {
+	var friends bool
+
+	sql := "SELECT EXISTS (SELECT 1 FROM friends WHERE user_id = $1 AND friend_id = $2)"
+	err := pg.QueryRow(context.Background(), sql, userID, otherID).Scan(&friends)
+	if err != nil {
+		return false, fmt.Errorf("are friends query row error: %v", err)
+	}
+
+	return friends, nil
+}
+
+// Return the number of friends the user has.
+func countFriends(userID int, pg *pgxpool.Pool) (int, error) {
+	var count int
+
+	err := pg.QueryRow(context.Background(), "SELECT COUNT(*) FROM friends WHERE user_id = $1", userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count friends query row error: %v", err)
+	}
+
+	return count, nil
+}
+
+// Insert a friend request, false is returned if the request already exists.
+func insertFriendRequest(senderID, recipientID int, pg *pgxpool.Pool) (bool, error) {
+	sql := `INSERT INTO friend_requests (sender_id, recipient_id, created_at) VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING`
+	tag, err := pg.Exec(context.Background(), sql, senderID, recipientID, time.Now())
+	return tag.RowsAffected() == 1, err
+}
+
+// Delete a friend request, false is returned if there was no such request.
+func deleteFriendRequest(senderID, recipientID int, pg *pgxpool.Pool) (bool, error) {
+	sql := "DELETE FROM friend_requests WHERE sender_id = $1 AND recipient_id = $2"
+	tag, err := pg.Exec(context.Background(), sql, senderID, recipientID)
+	return tag.RowsAffected() == 1, err
+}
+
+// Accept the friend request from the sender. False is returned if there was no such request.
+func acceptFriendRequest(senderID, recipientID int, pg *pgxpool.Pool) (bool, error) {
+	tx, err := pg.Begin(context.Background())
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(context.Background())
+
+	sql := "DELETE FROM friend_requests WHERE sender_id = $1 AND recipient_id = $2"
+	tag, err := tx.Exec(context.Background(), sql, senderID, recipientID)
+	if err != nil {
+		return false, err
+	} else if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	// A request the recipient sent the other way is no longer needed
+	if _, err := tx.Exec(context.Background(), sql, recipientID, senderID); err != nil {
+		return false, err
+	}
+
+	sql = `INSERT INTO friends (user_id, friend_id, created_at) VALUES ($1, $2, $3), ($2, $1, $3)
+		ON CONFLICT DO NOTHING`
+	if _, err := tx.Exec(context.Background(), sql, senderID, recipientID, time.Now()); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit(context.Background())
+}
+
+// Delete the friendship between the users, false is returned if they were not friends.
+func deleteFriendship(userID, friendID int, pg *pgxpool.Pool) (bool, error) {
+	sql := `DELETE FROM friends WHERE (user_id = $1 AND friend_id = $2) OR (user_id = $2 AND friend_id = $1)`
+	tag, err := pg.Exec(context.Background(), sql, userID, friendID)
+	return tag.RowsAffected() > 0, err
+}
+
+func guildTagExists(tag string, pg *pgxpool.Pool) (bool, error) {
+	var exists bool
+	err := pg.QueryRow(context.Background(), "SELECT EXISTS (SELECT 1 FROM guilds WHERE tag = $1)", tag).Scan(&exists)
+	return exists, err
+}
+
+func guildNameExists(name string, pg *pgxpool.Pool) (bool, error) {
+	var exists bool
+	err := pg.QueryRow(context.Background(), "SELECT EXISTS (SELECT 1 FROM guilds WHERE LOWER(name) = LOWER($1))", name).Scan(&exists)
+	return exists, err
+}
+
+// Insert a guild with the user as its leader and return the guild ID.
+// The leader's pending guild invites and applications are removed.
+func insertGuild(tag, name string, leaderID int, pg *pgxpool.Pool) (int, error) {
+	tx, err := pg.Begin(context.Background())
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(context.Background())
+
+	var id int
+	now := time.Now()
+
+	sql := "INSERT INTO guilds (tag, name, created_at) VALUES ($1, $2, $3) RETURNING id"
+	if err := tx.QueryRow(context.Background(), sql, tag, name, now).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	sql = "INSERT INTO guild_members (guild_id, user_id, rank, joined_at) VALUES ($1, $2, $3, $4)"
+	if _, err := tx.Exec(context.Background(), sql, id, leaderID, proto.GuildRank_GUILD_RANK_LEADER, now); err != nil {
+		return 0, err
+	}
+
+	if err := deletePendingGuildRequests(tx, leaderID); err != nil {
+		return 0, err
+	}
+
+	return id, tx.Commit(context.Background())
+}
+
+// Delete every guild invite and application of the user.
+func deletePendingGuildRequests(tx pgx.Tx, userID int) error {
+	if _, err := tx.Exec(context.Background(), "DELETE FROM guild_invites WHERE user_id = $1", userID); err != nil {
+		return err
+	}
+	_, err := tx.Exec(context.Background(), "DELETE FROM guild_applications WHERE user_id = $1", userID)
+	return err
+}
+
+const guildColumns = `g.id, g.tag, g.name, g.motd, g.created_at,
+	(SELECT COUNT(*)::INTEGER FROM guild_members m WHERE m.guild_id = g.id)`
+
+// Scan a row selected with guildColumns.
+func scanGuild(row pgx.Row) (*proto.GuildInfo, error) {
+	guild := &proto.GuildInfo{}
+
+	var createdAt time.Time
+	err := row.Scan(&guild.Id, &guild.Tag, &guild.Name, &guild.Motd, &createdAt, &guild.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	guild.CreatedAt = createdAt.UnixNano() / int64(time.Millisecond)
+	return guild, nil
+}
+
+// Return the guild with the given ID, nil is returned if there is no such guild.
+func findGuild(id int, pg *pgxpool.Pool) (*proto.GuildInfo, error) {
+	sql := "SELECT " + guildColumns + " FROM guilds g WHERE g.id = $1"
+	guild, err := scanGuild(pg.QueryRow(context.Background(), sql, id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("find guild query row error: %v", err)
+	}
+	return guild, nil
+}
+
+// Return the guild with the given tag, nil is returned if there is no such guild.
+func findGuildByTag(tag string, pg *pgxpool.Pool) (*proto.GuildInfo, error) {
+	sql := "SELECT " + guildColumns + " FROM guilds g WHERE g.tag = $1"
+	guild, err := scanGuild(pg.QueryRow(context.Background(), sql, tag))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("find guild by tag query row error: %v", err)
+	}
+	return guild, nil
+}
+
+// Return the ID of the user's guild and their rank, the ID is zero if the user is not in a guild.
+func findGuildMember(userID int, pg *pgxpool.Pool) (int, proto.GuildRank, error) {
+	var guildID int
+	var rank proto.GuildRank
+
+	sql := "SELECT guild_id, rank FROM guild_members WHERE user_id = $1"
+	err := pg.QueryRow(context.Background(), sql, userID).Scan(&guildID, &rank)
+	if err == pgx.ErrNoRows {
+		return 0, 0, nil
+	} else if err != nil {
+		return 0, 0, fmt.Errorf("find guild member query row error: %v", err)
+	}
+
+	return guildID, rank, nil
+}
+
+// Return the IDs of the members of the guild.
+func findGuildMemberIDs(guildID int, pg *pgxpool.Pool) (map[int]bool, error) {
+	rows, err := pg.Query(context.Background(), "SELECT user_id FROM guild_members WHERE guild_id = $1", guildID)
+	if err != nil {
+		return nil, fmt.Errorf("find guild member ids error: %v", err)
+	}
+	defer rows.Close()
+
+	ids := make(map[int]bool)
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("find guild member ids scan error: %v", err)
+		}
+		ids[id] = true
+	}
+
+	return ids, rows.Err()
+}
+
+// Return the members of the guild, highest rank first.
+func findGuildMembers(guildID int, pg *pgxpool.Pool) ([]*proto.GuildMember, error) {
+	sql := `SELECT u.id, u.name, m.rank, m.joined_at FROM guild_members m JOIN users u ON u.id = m.user_id
+		WHERE m.guild_id = $1 ORDER BY m.rank DESC, m.joined_at`
+
+	rows, err := pg.Query(context.Background(), sql, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("find guild members query error: %v", err)
+	}
+	defer rows.Close()
+
+	members := make([]*proto.GuildMember, 0)
+	for rows.Next() {
+		member := &proto.GuildMember{User: &proto.User{}}
+
+		var joinedAt time.Time
+		if err := rows.Scan(&member.User.Id, &member.User.Name, &member.Rank, &joinedAt); err != nil {
+			return nil, fmt.Errorf("find guild members scan error: %v", err)
+		}
+
+		member.JoinedAt = joinedAt.UnixNano() / int64(time.Millisecond)
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+// Return the users invited to the guild, oldest invite first.
+func findGuildInvitees(guildID int, pg *pgxpool.Pool) ([]*proto.User, error) {
+	sql := `SELECT u.id, u.name FROM guild_invites i JOIN users u ON u.id = i.user_id
+		WHERE i.guild_id = $1 ORDER BY i.created_at`
+	return queryUsers(sql, guildID, pg)
+}
+
+// Return the users that applied to the guild, oldest application first.
+func findGuildApplicants(guildID int, pg *pgxpool.Pool) ([]*proto.User, error) {
+	sql := `SELECT u.id, u.name FROM guild_applications a JOIN users u ON u.id = a.user_id
+		WHERE a.guild_id = $1 ORDER BY a.created_at`
+	return queryUsers(sql, guildID, pg)
+}
+
+// Return the guilds that invited the user, oldest invite first.
+func findUserGuildInvites(userID int, pg *pgxpool.Pool) ([]*proto.GuildInfo, error) {
+	sql := "SELECT " + guildColumns + ` FROM guild_invites i JOIN guilds g ON g.id = i.guild_id
+		WHERE i.user_id = $1 ORDER BY i.created_at`
+
+	rows, err := pg.Query(context.Background(), sql, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find user guild invites query error: %v", err)
+	}
+	defer rows.Close()
+
+	guilds := make([]*proto.GuildInfo, 0)
+	for rows.Next() {
+		guild, err := scanGuild(rows)
+		if err != nil {
+			return nil, fmt.Errorf("find user guild invites scan error: %v", err)
+		}
+		guild.Motd = "" // Only members see the message of the day
+		guilds = append(guilds, guild)
+	}
+
+	return guilds, rows.Err()
+}
+
+// Insert a guild invite, false is returned if the user was already invited.
+func insertGuildInvite(guildID, userID int, pg *pgxpool.Pool) (bool, error) {
+	sql := `INSERT INTO guild_invites (guild_id, user_id, created_at) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	tag, err := pg.Exec(context.Background(), sql, guildID, userID, time.Now())
+	return tag.RowsAffected() == 1, err
+}
+
+// Insert a guild application, false is returned if the user already applied.
+func insertGuildApplication(guildID, userID int, pg *pgxpool.Pool) (bool, error) {
+	sql := `INSERT INTO guild_applications (guild_id, user_id, created_at) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	tag, err := pg.Exec(context.Background(), sql, guildID, userID, time.Now())
+	return tag.RowsAffected() == 1, err
+}
+
+// Delete a guild invite, false is returned if there was no such invite.
+func deleteGuildInvite(guildID, userID int, pg *pgxpool.Pool) (bool, error) {
+	tag, err := pg.Exec(context.Background(), "DELETE FROM guild_invites WHERE guild_id = $1 AND user_id = $2", guildID, userID)
+	return tag.RowsAffected() == 1, err
+}
+
+// Delete a guild application, false is returned if there was no such application.
+func deleteGuildApplication(guildID, userID int, pg *pgxpool.Pool) (bool, error) {
+	tag, err := pg.Exec(context.Background(), "DELETE FROM guild_applications WHERE guild_id = $1 AND user_id = $2", guildID, userID)
+	return tag.RowsAffected() == 1, err
+}
+
+// guildRequest is the invite or application a user joins a guild with.
+type guildRequest int
+
+const (
+	guildInvite guildRequest = iota
+	guildApplication
+)
+
+// Add the user to the guild as a member using up their invite or application.
+// False is returned if there was no invite or application. The user's other invites and applications are removed.
+func addGuildMember(guildID, userID int, request guildRequest, pg *pgxpool.Pool) (bool, error) {
+	tx, err := pg.Begin(context.Background())
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(context.Background())
+
+	// Lock the guild so members are counted one at a time
+	var count int
+	sql := `SELECT (SELECT COUNT(*) FROM guild_members WHERE guild_id = g.id) FROM guilds g WHERE g.id = $1 FOR UPDATE`
+	err = tx.QueryRow(context.Background(), sql, guildID).Scan(&count)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	} else if count >= maxGuildMembers {
+		return false, errGuildFull
+	}
+
+	sql = "DELETE FROM guild_invites WHERE guild_id = $1 AND user_id = $2"
+	if request == guildApplication {
+		sql = "DELETE FROM guild_applications WHERE guild_id = $1 AND user_id = $2"
+	}
+
+	tag, err := tx.Exec(context.Background(), sql, guildID, userID)
+	if err != nil {
+		return false, err
+	} else if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	sql = `INSERT INTO guild_members (guild_id, user_id, rank, joined_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING`
+	tag, err = tx.Exec(context.Background(), sql, guildID, userID, proto.GuildRank_GUILD_RANK_MEMBER, time.Now())
+	if err != nil {
+		return false, err
+	} else if tag.RowsAffected() == 0 {
+		return false, errInGuild
+	}
+
+	if err := deletePendingGuildRequests(tx, userID); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit(context.Background())
+}
+
+// Remove a member from the guild, false is returned if the user was not a member.
+func deleteGuildMember(guildID, userID int, pg *pgxpool.Pool) (bool, error) {
+	tag, err := pg.Exec(context.Background(), "DELETE FROM guild_members WHERE guild_id = $1 AND user_id = $2", guildID, userID)
+	return tag.RowsAffected() == 1, err
+}
+
+// Change the rank of a member, false is returned if the user is not a member.
+func updateGuildRank(guildID, userID int, rank proto.GuildRank, pg *pgxpool.Pool) (bool, error) {
+	sql := "UPDATE guild_members SET rank = $3 WHERE guild_id = $1 AND user_id = $2"
+	tag, err := pg.Exec(context.Background(), sql, guildID, userID, rank)
+	return tag.RowsAffected() == 1, err
+}
+
+// Make a member the leader of the guild, the current leader becomes an officer.
+// False is returned if the user is not a member.
+func transferGuildLeader(guildID, leaderID, userID int, pg *pgxpool.Pool) (bool, error) {
+	tx, err := pg.Begin(context.Background())
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(context.Background())
+
+	sql := "UPDATE guild_members SET rank = $3 WHERE guild_id = $1 AND user_id = $2"
+	tag, err := tx.Exec(context.Background(), sql, guildID, userID, proto.GuildRank_GUILD_RANK_LEADER)
+	if err != nil {
+		return false, err
+	} else if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	if _, err := tx.Exec(context.Background(), sql, guildID, leaderID, proto.GuildRank_GUILD_RANK_OFFICER); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit(context.Background())
+}
+
+func updateGuildMotd(guildID int, motd string, pg *pgxpool.Pool) error {
+	_, err := pg.Exec(context.Background(), "UPDATE guilds SET motd = $2 WHERE id = $1", guildID, motd)
+	return err
+}
+
+// Delete the guild along with its members, invites and applications.
+func deleteGuild(guildID int, pg *pgxpool.Pool) error {
+	_, err := pg.Exec(context.Background(), "DELETE FROM guilds WHERE id = $1", guildID)
+	return err
+}
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS chat_messages (
+    id BIGSERIAL PRIMARY KEY,
+    channel VARCHAR(32) NOT NULL,
+    sender_id INTEGER NOT NULL REFERENCES users (id),
+    text VARCHAR NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS chat_messages_channel_idx ON chat_messages (channel, id)
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS user_blocks (
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    blocked_id INTEGER NOT NULL REFERENCES users (id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (user_id, blocked_id)
+)
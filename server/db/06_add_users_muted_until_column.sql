@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS muted_until TIMESTAMP WITH TIME ZONE
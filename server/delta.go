@@ -0,0 +1,157 @@
+package main
+
+import (
+	"math"
+	"sort"
+
+	"github.com/cdrpl/granny/server/proto"
+)
+
+// worldState is the quantized state of every entity at a tick. Recent states are kept as baselines for delta snapshots.
+type worldState struct {
+	tick     int64
+	entities map[int]quantizedEntity // User ID to the state of the user's entity
+}
+
+// quantizedEntity is the state of an entity with positions and velocities rounded to 1/positionScale units.
+type quantizedEntity struct {
+	x, y     int32
+	vx, vy   int32
+	inputSeq int64
+}
+
+// Round the value to the nearest 1/positionScale units.
+func quantize(v float64) int32 {
+	return int32(math.Round(v * positionScale))
+}
+
+// Convert a quantized value back to units.
+func dequantize(v int32) float64 {
+	return float64(v) / positionScale
+}
+
+// Return the quantized state of every entity.
+func (sim *Simulation) state() *worldState {
+	state := &worldState{tick: sim.tick, entities: make(map[int]quantizedEntity, len(sim.entities))}
+
+	for id, entity := range sim.entities {
+		state.entities[id] = quantizedEntity{
+			x:        quantize(entity.position.X),
+			y:        quantize(entity.position.Y),
+			vx:       quantize(entity.velocity.X),
+			vy:       quantize(entity.velocity.Y),
+			inputSeq: entity.inputSeq,
+		}
+	}
+
+	return state
+}
+
+// Record the current state so it can be used as a baseline, states older than maxBaselineAge ticks are dropped.
+func (sim *Simulation) record() *worldState {
+	state := sim.state()
+
+	sim.states = append(sim.states, state)
+	if len(sim.states) > maxBaselineAge {
+		sim.states = sim.states[len(sim.states)-maxBaselineAge:]
+	}
+
+	return state
+}
+
+// Return the last state the user acknowledged with only the entities that were in their view.
+// Nil is returned if the user has not acknowledged a state that is recent enough and needs a full snapshot.
+func (sim *Simulation) baseline(userID int) *worldState {
+	client, ok := sim.clients[userID]
+	if !ok || client.ackTick == 0 || len(sim.states) == 0 {
+		return nil
+	}
+
+	view, ok := client.views[client.ackTick]
+	if !ok {
+		return nil
+	}
+
+	// A state is recorded every tick so the index follows from the tick
+	i := client.ackTick - sim.states[0].tick
+	if i < 0 || i >= int64(len(sim.states)) {
+		return nil
+	}
+
+	return sim.states[i].filter(view)
+}
+
+// Return a copy of the state with only the entities in the view.
+func (s *worldState) filter(view map[int]bool) *worldState {
+	filtered := &worldState{tick: s.tick, entities: make(map[int]quantizedEntity, len(view))}
+	for id := range view {
+		if entity, ok := s.entities[id]; ok {
+			filtered.entities[id] = entity
+		}
+	}
+	return filtered
+}
+
+// Record that the user received the snapshot of the tick. Acknowledgements only move forward.
+func (sim *Simulation) ackSnapshot(userID int, tick int64) {
+	if tick > sim.tick {
+		return // Snapshot has not been sent yet
+	}
+
+	client := sim.client(userID)
+	if tick > client.ackTick {
+		client.ackTick = tick
+	}
+}
+
+// Encode the state as a delta against the baseline, a full snapshot is encoded if the baseline is nil.
+// Entities that have not changed since the baseline are left out.
+func encodeSnapshot(state, baseline *worldState) *proto.GameSnapshot {
+	snapshot := &proto.GameSnapshot{
+		Tick:     state.tick,
+		Entities: make([]*proto.EntityState, 0, len(state.entities)),
+	}
+
+	if baseline != nil {
+		snapshot.BaselineTick = baseline.tick
+	}
+
+	for id, entity := range state.entities {
+		var base quantizedEntity
+		if baseline != nil {
+			if b, ok := baseline.entities[id]; ok {
+				if b == entity {
+					continue
+				}
+				base = b
+			}
+		}
+
+		snapshot.Entities = append(snapshot.Entities, &proto.EntityState{
+			Id:        int32(id),
+			X:         entity.x - base.x,
+			Y:         entity.y - base.y,
+			VelocityX: entity.vx - base.vx,
+			VelocityY: entity.vy - base.vy,
+			InputSeq:  entity.inputSeq - base.inputSeq,
+		})
+	}
+
+	if baseline != nil {
+		for id := range baseline.entities {
+			if _, ok := state.entities[id]; !ok {
+				snapshot.Removed = append(snapshot.Removed, int32(id))
+			}
+		}
+	}
+
+	// Sorted so snapshots of the same state are identical
+	sort.Slice(snapshot.Entities, func(i, j int) bool {
+		return snapshot.Entities[i].Id < snapshot.Entities[j].Id
+	})
+	sort.Slice(snapshot.Removed, func(i, j int) bool {
+		return snapshot.Removed[i] < snapshot.Removed[j]
+	})
+
+	return snapshot
+}
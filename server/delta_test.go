@@ -0,0 +1,212 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cdrpl/granny/server/proto"
+)
+
+// Apply the snapshot to the baseline the way a client does and return the resulting state.
+func applySnapshot(baseline *worldState, snapshot *proto.GameSnapshot) *worldState {
+	state := &worldState{tick: snapshot.Tick, entities: make(map[int]quantizedEntity)}
+	if snapshot.BaselineTick != 0 {
+		for id, entity := range baseline.entities {
+			state.entities[id] = entity
+		}
+	}
+
+	for _, id := range snapshot.Removed {
+		delete(state.entities, int(id))
+	}
+
+	for _, e := range snapshot.Entities {
+		base := state.entities[int(e.Id)]
+		state.entities[int(e.Id)] = quantizedEntity{
+			x:        base.x + e.X,
+			y:        base.y + e.Y,
+			vx:       base.vx + e.VelocityX,
+			vy:       base.vy + e.VelocityY,
+			inputSeq: base.inputSeq + e.InputSeq,
+		}
+	}
+
+	return state
+}
+
+func TestEncodeSnapshotRoundTrip(t *testing.T) {
+	a := quantizedEntity{x: 100, y: -250, vx: 30, vy: 0, inputSeq: 4}
+	b := quantizedEntity{x: -7, y: 12, vx: 0, vy: -30, inputSeq: 9}
+	movedA := quantizedEntity{x: 103, y: -250, vx: 30, vy: 0, inputSeq: 5}
+	c := quantizedEntity{x: 500, y: 500}
+
+	tests := []struct {
+		name         string
+		state        *worldState
+		baseline     *worldState
+		wantEntities []int32
+		wantRemoved  []int32
+	}{
+		{"full", &worldState{tick: 5, entities: map[int]quantizedEntity{1: a, 2: b}}, nil, []int32{1, 2}, nil},
+		{"empty full", &worldState{tick: 5, entities: map[int]quantizedEntity{}}, nil, []int32{}, nil},
+		{"unchanged",
+			&worldState{tick: 6, entities: map[int]quantizedEntity{1: a, 2: b}},
+			&worldState{tick: 5, entities: map[int]quantizedEntity{1: a, 2: b}},
+			[]int32{}, nil},
+		{"moved",
+			&worldState{tick: 6, entities: map[int]quantizedEntity{1: movedA, 2: b}},
+			&worldState{tick: 5, entities: map[int]quantizedEntity{1: a, 2: b}},
+			[]int32{1}, nil},
+		{"moved back",
+			&worldState{tick: 6, entities: map[int]quantizedEntity{1: a}},
+			&worldState{tick: 5, entities: map[int]quantizedEntity{1: movedA}},
+			[]int32{1}, nil},
+		{"entered",
+			&worldState{tick: 9, entities: map[int]quantizedEntity{1: a, 3: c}},
+			&worldState{tick: 5, entities: map[int]quantizedEntity{1: a}},
+			[]int32{3}, nil},
+		{"removed",
+			&worldState{tick: 9, entities: map[int]quantizedEntity{2: b}},
+			&worldState{tick: 5, entities: map[int]quantizedEntity{1: a, 2: b, 3: c}},
+			[]int32{}, []int32{1, 3}},
+		{"everything changed",
+			&worldState{tick: 9, entities: map[int]quantizedEntity{1: movedA, 3: c}},
+			&worldState{tick: 5, entities: map[int]quantizedEntity{1: a, 2: b}},
+			[]int32{1, 3}, []int32{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := encodeSnapshot(tt.state, tt.baseline)
+
+			wantBaseline := int64(0)
+			if tt.baseline != nil {
+				wantBaseline = tt.baseline.tick
+			}
+			if snapshot.Tick != tt.state.tick || snapshot.BaselineTick != wantBaseline {
+				t.Errorf("tick, baseline tick = %v, %v, want %v, %v", snapshot.Tick, snapshot.BaselineTick, tt.state.tick, wantBaseline)
+			}
+
+			ids := make([]int32, len(snapshot.Entities))
+			for i, entity := range snapshot.Entities {
+				ids[i] = entity.Id
+			}
+			if !reflect.DeepEqual(ids, tt.wantEntities) {
+				t.Errorf("entities = %v, want %v", ids, tt.wantEntities)
+			}
+			if !reflect.DeepEqual(snapshot.Removed, tt.wantRemoved) {
+				t.Errorf("removed = %v, want %v", snapshot.Removed, tt.wantRemoved)
+			}
+
+			if got := applySnapshot(tt.baseline, snapshot); !reflect.DeepEqual(got, tt.state) {
+				t.Errorf("applied snapshot = %+v, want %+v", got, tt.state)
+			}
+		})
+	}
+}
+
+// Run the simulation for the ticks, sending every user a snapshot of every entity each tick.
+func runSnapshots(sim *Simulation, ticks int) {
+	for i := 0; i < ticks; i++ {
+		sim.step()
+		state := sim.record()
+		for id := range sim.entities {
+			view := make(map[int]bool, len(sim.entities))
+			for other := range sim.entities {
+				view[other] = true
+			}
+			sim.client(id).setView(state.tick, view)
+		}
+	}
+}
+
+func TestSnapshotBaseline(t *testing.T) {
+	tests := []struct {
+		name     string
+		ticks    int
+		acks     []int64
+		wantTick int64 // Zero for a full snapshot
+	}{
+		{"never acked", 10, nil, 0},
+		{"latest tick", 10, []int64{10}, 10},
+		{"older tick", 10, []int64{3}, 3},
+		{"oldest baseline", maxBaselineAge + 10, []int64{11}, 11},
+		{"expired", maxBaselineAge + 10, []int64{10}, 0},
+		{"expired long ago", 3 * maxBaselineAge, []int64{1}, 0},
+		{"tick not sent yet", 10, []int64{11}, 0},
+		{"only moves forward", 10, []int64{8, 5}, 8},
+		{"future tick ignored", 10, []int64{6, 20}, 6},
+		{"idle player acks", 10, []int64{2, 4, 9}, 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sim := newSimulation(defaultTickRate, nil, []int{1, 2})
+			runSnapshots(sim, tt.ticks)
+
+			for _, tick := range tt.acks {
+				sim.ackSnapshot(1, tick)
+			}
+
+			baseline := sim.baseline(1)
+			if tt.wantTick == 0 {
+				if baseline != nil {
+					t.Errorf("baseline tick = %v, want a full snapshot", baseline.tick)
+				}
+				return
+			}
+			if baseline == nil {
+				t.Fatalf("baseline = nil, want tick %v", tt.wantTick)
+			}
+			if baseline.tick != tt.wantTick || !reflect.DeepEqual(baseline, sim.states[tt.wantTick-sim.states[0].tick]) {
+				t.Errorf("baseline = %+v, want the state of tick %v", baseline, tt.wantTick)
+			}
+		})
+	}
+}
+
+// The acknowledged baseline stops being used once it is older than maxBaselineAge, so a player that acknowledges
+// once and then goes quiet falls back to full snapshots until they acknowledge again.
+func TestSnapshotBaselineExpires(t *testing.T) {
+	sim := newSimulation(defaultTickRate, nil, []int{1})
+	runSnapshots(sim, 5)
+	sim.ackSnapshot(1, 5)
+
+	for i := 1; i < maxBaselineAge; i++ {
+		runSnapshots(sim, 1)
+		if sim.baseline(1) == nil {
+			t.Fatalf("baseline expired %v ticks after the ack, want it kept for %v", i, maxBaselineAge)
+		}
+	}
+
+	runSnapshots(sim, 1)
+	if baseline := sim.baseline(1); baseline != nil {
+		t.Fatalf("baseline tick %v still used at tick %v", baseline.tick, sim.tick)
+	}
+
+	sim.ackSnapshot(1, sim.tick)
+	if baseline := sim.baseline(1); baseline == nil || baseline.tick != sim.tick {
+		t.Errorf("baseline = %v after a new ack, want tick %v", baseline, sim.tick)
+	}
+}
+
+func TestRoomAckSnapshot(t *testing.T) {
+	room := &Room{users: map[int]*RoomUser{1: newRoomUser(1, "one")}}
+
+	if err := room.ackSnapshot(1, 1); err != errNotInProgress {
+		t.Errorf("ack before the game started, error = %v, want %v", err, errNotInProgress)
+	}
+
+	room.sim = newSimulation(defaultTickRate, nil, []int{1})
+	runSnapshots(room.sim, 3)
+
+	if err := room.ackSnapshot(2, 3); err != errNotInRoom {
+		t.Errorf("ack from a user not in the room, error = %v, want %v", err, errNotInRoom)
+	}
+	if err := room.ackSnapshot(1, 3); err != nil {
+		t.Fatalf("ackSnapshot() error = %v", err)
+	}
+	if baseline := room.sim.baseline(1); baseline == nil || baseline.tick != 3 {
+		t.Errorf("baseline = %v, want tick 3", baseline)
+	}
+}
@@ -0,0 +1,689 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cdrpl/granny/server/proto"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Redis keys used by the room directory.
+const (
+	instanceKeyPrefix = "instance:"       // instance:<instance id> holds the gRPC address of a running instance
+	roomOwnersKey     = "room-owners"     // Hash of room ID to the ID of the instance that owns the room
+	roomInfoKey       = "room-info"       // Hash of room ID to the latest serialized RoomInfo
+	roomSpectatorsKey = "room-spectators" // Hash of room ID to the number of spectators on every instance
+	userRoomsKey      = "user-rooms"      // Hash of user ID to the ID of the room the user is in
+	roomEventsPrefix  = "room-events:"    // room-events:<room id> is the pub/sub channel for room events
+	snapshotKeyPrefix = "room-snapshot:"  // room-snapshot:<room id> holds the latest serialized RoomSnapshot
+	instanceMapsKey   = "instance-maps"   // Hash of instance ID to the checksum of the instance's world map
+	instanceSecretKey = "instance-secret" // Secret shared by every instance to prove a request was forwarded by one of them
+)
+
+// Directory maps rooms to the server instances that own them. It is shared between every instance through Redis.
+// The owning instance holds the room state, other instances route requests to the owner and receive room events over pub/sub.
+type Directory struct {
+	rdb        *redis.Client
+	instanceID string
+	addr       string                       // gRPC address other instances use to reach this instance
+	secret     string                       // Shared by every instance, sent with forwarded requests
+	conns      map[string]*grpc.ClientConn  // Instance address to connection
+	subs       map[string]*roomSubscription // Room ID to the pub/sub subscription for the room
+	publish    chan publishedEvent          // Room events waiting to be published by publishLoop
+	mut        sync.Mutex
+}
+
+// publishedEvent is a serialized room event and the room info after the event, snapshots have no room info.
+type publishedEvent struct {
+	roomID          string
+	event, roomInfo []byte
+}
+
+func newDirectory(rdb *redis.Client, instanceID, addr string) *Directory {
+	return &Directory{
+		rdb:        rdb,
+		instanceID: instanceID,
+		addr:       addr,
+		conns:      make(map[string]*grpc.ClientConn),
+		subs:       make(map[string]*roomSubscription),
+		publish:    make(chan publishedEvent, publishBuffer),
+	}
+}
+
+// Load the secret shared by every instance, the first instance to start creates it.
+func (d *Directory) loadSecret() error {
+	secret, err := generateToken(tokenBytes)
+	if err != nil {
+		return err
+	}
+
+	if err := d.rdb.SetNX(context.Background(), instanceSecretKey, secret, 0).Err(); err != nil {
+		return err
+	}
+
+	d.secret, err = d.rdb.Get(context.Background(), instanceSecretKey).Result()
+	return err
+}
+
+// Keep the instance registered, instances that stop refreshing their key are considered dead.
+func (d *Directory) heartbeat() {
+	for {
+		err := d.rdb.Set(context.Background(), instanceKeyPrefix+d.instanceID, d.addr, instanceTTL).Err()
+		if err != nil {
+			log.Println("directory heartbeat error:", err)
+		}
+
+		time.Sleep(heartbeatInterval)
+	}
+}
+
+// Register this instance as the owner of the room.
+func (d *Directory) registerRoom(info *proto.RoomInfo) error {
+	bytes, err := protobuf.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.rdb.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.HSet(context.Background(), roomOwnersKey, info.Id, d.instanceID)
+		pipe.HSet(context.Background(), roomInfoKey, info.Id, bytes)
+		return nil
+	})
+	return err
+}
+
+// Remove the room from the directory.
+func (d *Directory) unregisterRoom(id string) error {
+	_, err := d.rdb.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.HDel(context.Background(), roomOwnersKey, id)
+		pipe.HDel(context.Background(), roomInfoKey, id)
+		pipe.HDel(context.Background(), roomSpectatorsKey, id)
+		pipe.Del(context.Background(), snapshotKeyPrefix+id)
+		return nil
+	})
+	return err
+}
+
+// Remove the room from the directory along with every user in it.
+func (d *Directory) removeRoom(id string) error {
+	userRooms, err := d.rdb.HGetAll(context.Background(), userRoomsKey).Result()
+	if err != nil {
+		return err
+	}
+
+	for userID, roomID := range userRooms {
+		if roomID == id {
+			uid, _ := strconv.Atoi(userID)
+			if err := d.releaseUser(uid); err != nil {
+				return err
+			}
+		}
+	}
+
+	return d.unregisterRoom(id)
+}
+
+// Return the IDs of the rooms owned by this instance.
+func (d *Directory) ownedRooms() ([]string, error) {
+	owners, err := d.rdb.HGetAll(context.Background(), roomOwnersKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0)
+	for roomID, instanceID := range owners {
+		if instanceID == d.instanceID {
+			ids = append(ids, roomID)
+		}
+	}
+
+	return ids, nil
+}
+
+// Save the room snapshots, snapshots expire if the instance stops saving them.
+func (d *Directory) saveSnapshots(snapshots []*proto.RoomSnapshot) error {
+	_, err := d.rdb.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		for _, snapshot := range snapshots {
+			bytes, err := protobuf.Marshal(snapshot)
+			if err != nil {
+				return err
+			}
+			pipe.Set(context.Background(), snapshotKeyPrefix+snapshot.Room.Id, bytes, snapshotTTL)
+		}
+		return nil
+	})
+	return err
+}
+
+// Return the latest snapshot of the room or nil if there is no snapshot.
+func (d *Directory) loadSnapshot(roomID string) (*proto.RoomSnapshot, error) {
+	bytes, err := d.rdb.Get(context.Background(), snapshotKeyPrefix+roomID).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	snapshot := &proto.RoomSnapshot{}
+	if err := protobuf.Unmarshal(bytes, snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// Queue a room event to be published to every instance along with the latest room info. The event is published
+// by publishLoop so rooms never wait on Redis, events are dropped if the queue is full.
+func (d *Directory) publishEvent(event *proto.RoomEvent, info *proto.RoomInfo) error {
+	eventBytes, err := protobuf.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	infoBytes, err := protobuf.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case d.publish <- publishedEvent{roomID: info.Id, event: eventBytes, roomInfo: infoBytes}:
+		return nil
+	default:
+		return errors.New("publish queue is full")
+	}
+}
+
+// Queue a game snapshot to be published for spectators. Snapshots do not change the room info so it is not saved.
+func (d *Directory) publishSnapshot(roomID string, event *proto.RoomEvent) error {
+	eventBytes, err := protobuf.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case d.publish <- publishedEvent{roomID: roomID, event: eventBytes}:
+		return nil
+	default:
+		return errors.New("publish queue is full")
+	}
+}
+
+// Publish queued room events in the order they were queued.
+func (d *Directory) publishLoop() {
+	for published := range d.publish {
+		_, err := d.rdb.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+			if published.roomInfo != nil {
+				pipe.HSet(context.Background(), roomInfoKey, published.roomID, published.roomInfo)
+			}
+			pipe.Publish(context.Background(), roomEventsPrefix+published.roomID, published.event)
+			return nil
+		})
+		if err != nil {
+			log.Println("publish room event error:", err)
+		}
+	}
+}
+
+// Record the user as being in the room. Return false if the user is already in a room. A claim on a room that
+// was abandoned by its instance is dropped so the user is not stuck in a room that no longer exists.
+func (d *Directory) claimUser(userID int, roomID string) (bool, error) {
+	claimed, err := d.rdb.HSetNX(context.Background(), userRoomsKey, strconv.Itoa(userID), roomID).Result()
+	if err != nil {
+		return false, err
+	}
+
+	if !claimed {
+		current, err := d.userRoom(userID)
+		if err != nil {
+			return false, err
+		}
+
+		if current != "" {
+			abandoned, err := d.abandoned(current)
+			if err != nil || !abandoned {
+				return false, err
+			}
+			if err := d.releaseClaim(userID, current); err != nil {
+				return false, err
+			}
+		}
+
+		claimed, err = d.rdb.HSetNX(context.Background(), userRoomsKey, strconv.Itoa(userID), roomID).Result()
+		if err != nil || !claimed {
+			return false, err
+		}
+	}
+
+	return true, d.publishPresence(userID)
+}
+
+// Deletes the user's claim only if it is still on the room, so a claim made since it was read is kept.
+var releaseClaimScript = redis.NewScript(`
+if redis.call("HGET", KEYS[1], ARGV[1]) == ARGV[2] then
+	return redis.call("HDEL", KEYS[1], ARGV[1])
+end
+return 0
+`)
+
+// Remove the user's claim on the room, nothing is removed if the user has since claimed another room.
+func (d *Directory) releaseClaim(userID int, roomID string) error {
+	released, err := releaseClaimScript.Run(context.Background(), d.rdb, []string{userRoomsKey}, userID, roomID).Int()
+	if err != nil || released == 0 {
+		return err
+	}
+	return d.publishPresence(userID)
+}
+
+// Check if the room no longer exists or its instance stopped and did not restart within restoreWindow.
+func (d *Directory) abandoned(roomID string) (bool, error) {
+	owner, err := d.owner(roomID)
+	if err != nil || owner == "" {
+		return owner == "", err
+	} else if owner == d.instanceID {
+		return false, nil
+	}
+
+	live, err := d.rdb.Exists(context.Background(), instanceKeyPrefix+owner).Result()
+	if err != nil || live == 1 {
+		return false, err
+	}
+	return d.expired(roomID)
+}
+
+// Check if a room whose instance is not running is past the time its instance had to restart and restore it.
+// Rooms are snapshotted until their instance stops, so the age of the last snapshot is how long it has been stopped.
+func (d *Directory) expired(roomID string) (bool, error) {
+	ttl, err := d.rdb.PTTL(context.Background(), snapshotKeyPrefix+roomID).Result()
+	if err != nil {
+		return false, err
+	}
+	return ttl <= 0 || snapshotTTL-ttl > restoreWindow, nil
+}
+
+// Remove the rooms abandoned by instances that stopped, and every claim on a room that no longer exists,
+// so users are never stuck in a room nobody owns.
+func (d *Directory) sweepRooms() error {
+	ctx := context.Background()
+
+	// Claims are read before the owners so a claim is never read without the room it was made on
+	claims, err := d.rdb.HGetAll(ctx, userRoomsKey).Result()
+	if err != nil {
+		return err
+	}
+
+	owners, err := d.rdb.HGetAll(ctx, roomOwnersKey).Result()
+	if err != nil {
+		return err
+	}
+
+	ids, err := d.liveInstances()
+	if err != nil {
+		return err
+	}
+	live := map[string]bool{d.instanceID: true} // This instance is running even if its first heartbeat has not been sent
+	for _, id := range ids {
+		live[id] = true
+	}
+
+	abandoned := make(map[string]bool)
+	for roomID, owner := range owners {
+		if live[owner] {
+			continue
+		}
+		expired, err := d.expired(roomID)
+		if err != nil {
+			return err
+		}
+		abandoned[roomID] = expired
+	}
+
+	for userID, roomID := range claims {
+		if _, ok := owners[roomID]; ok && !abandoned[roomID] {
+			continue
+		}
+
+		uid, _ := strconv.Atoi(userID)
+		if err := d.releaseClaim(uid, roomID); err != nil {
+			return err
+		}
+	}
+
+	for roomID, expired := range abandoned {
+		if !expired {
+			continue
+		}
+		if err := d.unregisterRoom(roomID); err != nil {
+			return err
+		}
+		log.Printf("Removed room %v abandoned by instance %v\n", roomID, owners[roomID])
+	}
+
+	return nil
+}
+
+// Sweep abandoned rooms and claims every sweepInterval.
+func (d *Directory) sweepLoop() {
+	for {
+		if err := d.sweepRooms(); err != nil {
+			log.Println("sweep rooms error:", err)
+		}
+
+		time.Sleep(sweepInterval)
+	}
+}
+
+// Remove the user from the room they are in.
+func (d *Directory) releaseUser(userID int) error {
+	if err := d.rdb.HDel(context.Background(), userRoomsKey, strconv.Itoa(userID)).Err(); err != nil {
+		return err
+	}
+	return d.publishPresence(userID)
+}
+
+// Let every instance know the user's presence has changed.
+func (d *Directory) publishPresence(userID int) error {
+	return d.rdb.Publish(context.Background(), presenceChanged, userID).Err()
+}
+
+// Return the IDs of every running instance.
+func (d *Directory) liveInstances() ([]string, error) {
+	ids := make([]string, 0)
+
+	iter := d.rdb.Scan(context.Background(), 0, instanceKeyPrefix+"*", 0).Iterator()
+	for iter.Next(context.Background()) {
+		ids = append(ids, strings.TrimPrefix(iter.Val(), instanceKeyPrefix))
+	}
+
+	return ids, iter.Err()
+}
+
+// Return the IDs of every running instance other than this one.
+func (d *Directory) otherInstances() ([]string, error) {
+	ids, err := d.liveInstances()
+	if err != nil {
+		return nil, err
+	}
+
+	others := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != d.instanceID {
+			others = append(others, id)
+		}
+	}
+	return others, nil
+}
+
+// Record the checksum of this instance's world map. An error is returned if a running instance has a different map.
+func (d *Directory) checkWorld(checksum uint32) error {
+	others, err := d.otherInstances()
+	if err != nil {
+		return err
+	}
+
+	sum := strconv.FormatUint(uint64(checksum), 10)
+
+	if len(others) > 0 {
+		sums, err := d.rdb.HMGet(context.Background(), instanceMapsKey, others...).Result()
+		if err != nil {
+			return err
+		}
+
+		for i, other := range sums {
+			if other != nil && other != sum {
+				return fmt.Errorf("map does not match the map of instance %v, set WORLD_SEED or share the map file", others[i])
+			}
+		}
+	}
+
+	return d.rdb.HSet(context.Background(), instanceMapsKey, d.instanceID, sum).Err()
+}
+
+// Return the ID of the room the user is in or an empty string if the user is not in a room.
+func (d *Directory) userRoom(userID int) (string, error) {
+	id, err := d.rdb.HGet(context.Background(), userRoomsKey, strconv.Itoa(userID)).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return id, err
+}
+
+// Return the IDs of the rooms the users are in.
+func (d *Directory) usersRooms(userIDs []int) (map[string]bool, error) {
+	fields := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		fields[i] = strconv.Itoa(id)
+	}
+
+	ids, err := d.rdb.HMGet(context.Background(), userRoomsKey, fields...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	rooms := make(map[string]bool)
+	for _, id := range ids {
+		if id, ok := id.(string); ok {
+			rooms[id] = true
+		}
+	}
+
+	return rooms, nil
+}
+
+// Return the ID of the instance that owns the room or an empty string if the room does not exist.
+func (d *Directory) owner(roomID string) (string, error) {
+	id, err := d.rdb.HGet(context.Background(), roomOwnersKey, roomID).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return id, err
+}
+
+// Return a room client for the instance, nil is returned if the instance is no longer running.
+func (d *Directory) client(instanceID string) (proto.RoomClient, error) {
+	addr, err := d.rdb.Get(context.Background(), instanceKeyPrefix+instanceID).Result()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	d.mut.Lock()
+	defer d.mut.Unlock()
+
+	conn, ok := d.conns[addr]
+	if !ok {
+		conn, err = grpc.Dial(addr, grpc.WithInsecure())
+		if err != nil {
+			return nil, fmt.Errorf("dial instance error: %v", err)
+		}
+		d.conns[addr] = conn
+	}
+
+	return proto.NewRoomClient(conn), nil
+}
+
+// Return the info of the room or nil if the room does not exist.
+func (d *Directory) roomInfo(id string) (*proto.RoomInfo, error) {
+	bytes, err := d.rdb.HGet(context.Background(), roomInfoKey, id).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	info := &proto.RoomInfo{}
+	if err := protobuf.Unmarshal(bytes, info); err != nil {
+		return nil, err
+	}
+
+	info.Spectators, err = d.spectators(id)
+	return info, err
+}
+
+// Return the info of every room owned by a running instance. Rooms of stopped instances are kept in the directory
+// until sweepRooms removes them in case the instance restarts, but they can not be joined in the meantime.
+func (d *Directory) roomInfos() ([]*proto.RoomInfo, error) {
+	ctx := context.Background()
+
+	owners, err := d.rdb.HGetAll(ctx, roomOwnersKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := d.rdb.HGetAll(ctx, roomInfoKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	spectators, err := d.rdb.HGetAll(ctx, roomSpectatorsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	// Find out which of the owners are still running
+	alive := make(map[string]bool)
+	for _, instanceID := range owners {
+		if _, ok := alive[instanceID]; ok {
+			continue
+		}
+		n, err := d.rdb.Exists(ctx, instanceKeyPrefix+instanceID).Result()
+		if err != nil {
+			return nil, err
+		}
+		alive[instanceID] = n == 1
+	}
+
+	list := make([]*proto.RoomInfo, 0, len(infos))
+	for id, raw := range infos {
+		if !alive[owners[id]] {
+			continue
+		}
+
+		info := &proto.RoomInfo{}
+		if err := protobuf.Unmarshal([]byte(raw), info); err != nil {
+			return nil, err
+		}
+
+		count, _ := strconv.Atoi(spectators[id])
+		info.Spectators = int32(count)
+		list = append(list, info)
+	}
+
+	return list, nil
+}
+
+// Return the number of spectators in the room across every instance.
+func (d *Directory) spectators(roomID string) (int32, error) {
+	count, err := d.rdb.HGet(context.Background(), roomSpectatorsKey, roomID).Int()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return int32(count), err
+}
+
+// Count a new spectator in the room. Return false if the room already has the max num spectators.
+func (d *Directory) addSpectator(roomID string) (bool, error) {
+	count, err := d.rdb.HIncrBy(context.Background(), roomSpectatorsKey, roomID, 1).Result()
+	if err != nil {
+		return false, err
+	}
+
+	if count > maxSpectators {
+		return false, d.removeSpectator(roomID)
+	}
+
+	return true, nil
+}
+
+func (d *Directory) removeSpectator(roomID string) error {
+	return d.rdb.HIncrBy(context.Background(), roomSpectatorsKey, roomID, -1).Err()
+}
+
+// roomSubscription fans the pub/sub events of one room out to every subscriber on this instance.
+type roomSubscription struct {
+	pubsub *redis.PubSub
+	subs   map[chan *proto.RoomEvent]bool
+}
+
+// Subscribe to the events of a room. The channel is closed when the room closes.
+func (d *Directory) subscribe(roomID string) (chan *proto.RoomEvent, error) {
+	d.mut.Lock()
+	defer d.mut.Unlock()
+
+	sub, ok := d.subs[roomID]
+	if !ok {
+		pubsub := d.rdb.Subscribe(context.Background(), roomEventsPrefix+roomID)
+
+		// Wait for the subscription to be confirmed so no events are missed
+		if _, err := pubsub.Receive(context.Background()); err != nil {
+			pubsub.Close()
+			return nil, err
+		}
+
+		sub = &roomSubscription{pubsub: pubsub, subs: make(map[chan *proto.RoomEvent]bool)}
+		d.subs[roomID] = sub
+		go d.fanOut(roomID, sub)
+	}
+
+	ch := make(chan *proto.RoomEvent, spectatorEventBuffer)
+	sub.subs[ch] = true
+
+	return ch, nil
+}
+
+// Remove the subscriber, the pub/sub subscription is closed once the room has no subscribers.
+func (d *Directory) unsubscribe(roomID string, ch chan *proto.RoomEvent) {
+	d.mut.Lock()
+	defer d.mut.Unlock()
+
+	sub, ok := d.subs[roomID]
+	if !ok || !sub.subs[ch] {
+		return
+	}
+
+	delete(sub.subs, ch)
+	close(ch)
+
+	if len(sub.subs) == 0 {
+		delete(d.subs, roomID)
+		sub.pubsub.Close()
+	}
+}
+
+// Send every event received from pub/sub to the subscribers, subscribers that are not keeping up are skipped.
+func (d *Directory) fanOut(roomID string, sub *roomSubscription) {
+	for msg := range sub.pubsub.Channel() {
+		event := &proto.RoomEvent{}
+		if err := protobuf.Unmarshal([]byte(msg.Payload), event); err != nil {
+			log.Println("room event unmarshal error:", err)
+			continue
+		}
+
+		d.mut.Lock()
+		for ch := range sub.subs {
+			select {
+			case ch <- event:
+			default:
+			}
+		}
+
+		// End every subscription once the room has closed
+		if event.GetRoomClosed() != nil && d.subs[roomID] == sub {
+			for ch := range sub.subs {
+				close(ch)
+			}
+			delete(d.subs, roomID)
+			sub.pubsub.Close()
+		}
+		d.mut.Unlock()
+	}
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+	"unicode/utf8"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cdrpl/granny/server/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// inbox is an open direct message stream.
+type inbox struct {
+	userID   int
+	messages chan *proto.DirectMessage // Closed when the stream ends
+}
+
+// Open a direct message stream for the user, an existing stream for the user is closed.
+func (h *ChatHub) openInbox(userID int) *inbox {
+	in := &inbox{userID: userID, messages: make(chan *proto.DirectMessage, chatStreamBuffer)}
+
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	if old, ok := h.inboxes[userID]; ok {
+		close(old.messages)
+	}
+	h.inboxes[userID] = in
+
+	return in
+}
+
+// Close the inbox if it is still the user's inbox.
+func (h *ChatHub) closeInbox(in *inbox) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	if h.inboxes[in.userID] == in {
+		delete(h.inboxes, in.userID)
+		close(in.messages)
+	}
+}
+
+// Send the message to the recipient's inbox if it is open on this instance.
+// Messages that are dropped stay unread and are delivered the next time the recipient opens a stream.
+func (h *ChatHub) deliverDirect(msg *proto.DirectMessage) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	if in, ok := h.inboxes[int(msg.Recipient.Id)]; ok {
+		select {
+		case in.messages <- msg:
+		default:
+		}
+	}
+}
+
+// Send the direct message to every instance.
+func (h *ChatHub) publishDirect(msg *proto.DirectMessage) error {
+	bytes, err := protobuf.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return h.rdb.Publish(context.Background(), directMessages, bytes).Err()
+}
+
+// SendDirectMessage will save a message to another user and deliver it if they are online.
+func (s *Server) SendDirectMessage(ctx context.Context, in *proto.SendDirectMessageReq) (*proto.SendDirectMessageRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	if err := validateSendDirectMessageRequest(in); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sender, err := findUser(id, s.pg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "send direct message error: %v", err)
+	} else if sender.isMuted() {
+		return nil, status.Error(codes.PermissionDenied, "you are muted")
+	}
+
+	recipientID, err := s.resolveUser(in.GetUserId(), in.GetName())
+	if err != nil {
+		return nil, err
+	} else if recipientID == id {
+		return nil, status.Error(codes.InvalidArgument, "can not message yourself")
+	}
+
+	recipient, err := findUser(recipientID, s.pg)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	if err := s.checkNotBlocked(id, recipientID); err != nil {
+		return nil, err
+	}
+
+	if !s.chat.dmLimiter.allow(id) {
+		return nil, status.Error(codes.ResourceExhausted, "sending messages too fast")
+	}
+
+	if in.Text, err = s.filterChat(id, in.Text); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	msgID, err := insertDirectMessage(id, recipientID, in.Text, now, s.pg)
+	if err != nil {
+		log.Println("insert direct message error:", err)
+		return nil, status.Error(codes.Internal, "send direct message error")
+	}
+
+	msg := &proto.DirectMessage{
+		Id:        msgID,
+		Sender:    &proto.User{Id: int32(id), Name: sender.Name},
+		Recipient: &proto.User{Id: int32(recipientID), Name: recipient.Name},
+		Text:      in.Text,
+		Time:      now.UnixNano() / int64(time.Millisecond),
+	}
+
+	// The message is saved, a recipient that misses it live will receive it as unread
+	if err := s.chat.publishDirect(msg); err != nil {
+		log.Println("publish direct message error:", err)
+	}
+
+	return &proto.SendDirectMessageRes{Message: msg}, nil
+}
+
+// DirectMessages streams the user's unread direct messages followed by new messages as they arrive.
+func (s *Server) DirectMessages(req *proto.DirectMessagesReq, stream proto.Chat_DirectMessagesServer) error {
+	id, _, _ := extractUserIDAndToken(stream.Context())
+
+	// Open the inbox before loading unread messages so nothing sent in between is missed
+	in := s.chat.openInbox(id)
+	defer s.chat.closeInbox(in)
+
+	unread, err := findUnreadDirectMessages(id, s.pg)
+	if err != nil {
+		log.Println(err)
+		return status.Error(codes.Internal, "direct messages error")
+	}
+
+	var lastID int64
+	for _, msg := range unread {
+		lastID = msg.Id
+		if s.social.hides(id, int(msg.Sender.Id)) {
+			continue
+		}
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case msg, ok := <-in.messages:
+			if !ok {
+				return nil // Another stream was opened
+			}
+			if msg.Id <= lastID || s.social.hides(id, int(msg.Sender.Id)) {
+				continue // Already sent as unread or the sender is blocked
+			}
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// GetUnreadCounts will return the number of unread direct messages from each sender.
+func (s *Server) GetUnreadCounts(ctx context.Context, in *proto.GetUnreadCountsReq) (*proto.GetUnreadCountsRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	counts, err := countUnreadDirectMessages(id, s.pg)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "get unread counts error")
+	}
+
+	return &proto.GetUnreadCountsRes{Counts: counts}, nil
+}
+
+// MarkDirectMessagesRead will mark the messages from a sender as read.
+func (s *Server) MarkDirectMessagesRead(ctx context.Context, in *proto.MarkDirectMessagesReadReq) (*proto.MarkDirectMessagesReadRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	upToID := in.UpToId
+	if upToID <= 0 {
+		upToID = math.MaxInt64
+	}
+
+	if err := markDirectMessagesRead(id, int(in.SenderId), upToID, s.pg); err != nil {
+		log.Println("mark direct messages read error:", err)
+		return nil, status.Error(codes.Internal, "mark direct messages read error")
+	}
+
+	return &proto.MarkDirectMessagesReadRes{}, nil
+}
+
+// Return the total number of unread direct messages for the sign in response.
+func (s *Server) unreadMessages(userID int) int32 {
+	counts, err := countUnreadDirectMessages(userID, s.pg)
+	if err != nil {
+		log.Println(err)
+		return 0
+	}
+
+	var total int32
+	for _, count := range counts {
+		total += count.Count
+	}
+	return total
+}
+
+// SendDirectMessageValidator is used to validate send direct message requests.
+type SendDirectMessageValidator struct {
+	Text string `valid:"required"`
+}
+
+// Sanitize and validate the send direct message request.
+func validateSendDirectMessageRequest(req *proto.SendDirectMessageReq) error {
+	if name, ok := req.Recipient.(*proto.SendDirectMessageReq_Name); ok {
+		name.Name = govalidator.Trim(name.Name, "")
+		if name.Name == "" {
+			return errors.New("name is required")
+		}
+	} else if req.GetUserId() <= 0 {
+		return errors.New("recipient is required")
+	}
+
+	req.Text = govalidator.Trim(req.Text, "")
+	if utf8.RuneCountInString(req.Text) > maxChatLength {
+		return fmt.Errorf("text must be at most %d characters", maxChatLength)
+	}
+
+	v := SendDirectMessageValidator{Text: req.Text}
+	_, err := govalidator.ValidateStruct(v)
+	if err != nil {
+		return errors.New("text is required")
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -53,4 +54,22 @@ func verifyEnvVars() {
 		os.Setenv("REDIS_HOST", "127.0.0.1")
 		log.Println("The REDIS_HOST environment variable was not set, defaulting to 127.0.0.1")
 	}
+	if os.Getenv("TICK_RATE") == "" {
+		os.Setenv("TICK_RATE", strconv.Itoa(defaultTickRate))
+		log.Printf("The TICK_RATE environment variable was not set, defaulting to %v\n", defaultTickRate)
+	}
+	if os.Getenv("INSTANCE_ID") == "" || os.Getenv("INSTANCE_ADDR") == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			hostname = "127.0.0.1"
+		}
+		if os.Getenv("INSTANCE_ID") == "" {
+			os.Setenv("INSTANCE_ID", hostname)
+			log.Printf("The INSTANCE_ID environment variable was not set, defaulting to %v\n", hostname)
+		}
+		if os.Getenv("INSTANCE_ADDR") == "" {
+			os.Setenv("INSTANCE_ADDR", hostname+port)
+			log.Printf("The INSTANCE_ADDR environment variable was not set, defaulting to %v%v\n", hostname, port)
+		}
+	}
 }
@@ -0,0 +1,441 @@
+package main
+
+import (
+	"bufio"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FilterAction is what happens to text that a filter matches.
+type FilterAction int
+
+// Filter actions from least to most severe.
+const (
+	FilterAllow  FilterAction = iota // Text is unchanged
+	FilterMask                       // Matched parts of the text are replaced with asterisks
+	FilterReject                     // Text is rejected
+	FilterMute                       // Text is rejected and the user is muted
+)
+
+// FilterResult is the outcome of filtering text.
+type FilterResult struct {
+	Action FilterAction
+	Text   string // Text after masking
+	Reason string // Why the text was rejected
+}
+
+// TextFilter checks user generated text.
+type TextFilter interface {
+	Filter(userID int, text string) FilterResult
+}
+
+// FilterChain runs text through each filter in order.
+// Masked text is passed on to the next filter, the chain stops at the first reject or mute.
+type FilterChain []TextFilter
+
+// Filter the text with every filter in the chain.
+func (c FilterChain) Filter(userID int, text string) FilterResult {
+	result := FilterResult{Action: FilterAllow, Text: text}
+
+	for _, filter := range c {
+		r := filter.Filter(userID, result.Text)
+		switch r.Action {
+		case FilterMask:
+			result.Action = FilterMask
+			result.Text = r.Text
+		case FilterReject, FilterMute:
+			return r
+		}
+	}
+
+	return result
+}
+
+// Characters commonly used in place of letters.
+var leetspeak = map[rune]rune{
+	'0': 'o',
+	'1': 'i',
+	'3': 'e',
+	'4': 'a',
+	'5': 's',
+	'7': 't',
+	'8': 'b',
+	'@': 'a',
+	'$': 's',
+	'!': 'i',
+	'|': 'l',
+	'+': 't',
+}
+
+// textRun is a run of the same normalized letter, a zero letter marks a word boundary.
+type textRun struct {
+	letter     rune
+	count      int
+	start, end int // Rune positions of the run in the original text, end is exclusive
+}
+
+// Normalize the text into runs of letters. Text is lowercased, leetspeak is replaced
+// and punctuation is dropped so "B.@.D" and "baad" both normalize to letters of "bad".
+// Symbols only count as leetspeak when a letter or digit follows them, so "damn!" still ends in "n".
+func normalizeText(text string) []textRun {
+	runs := make([]textRun, 0, len(text))
+	runes := []rune(text)
+
+	for pos, r := range runes {
+		letter := unicode.ToLower(r)
+		if mapped, ok := leetspeak[letter]; ok && (unicode.IsDigit(letter) || followedByLetter(runes, pos)) {
+			letter = mapped
+		} else if unicode.IsSpace(letter) {
+			letter = 0
+		} else if !unicode.IsLetter(letter) && !unicode.IsDigit(letter) {
+			continue
+		}
+
+		if n := len(runs); n > 0 && runs[n-1].letter == letter {
+			runs[n-1].count++
+			runs[n-1].end = pos + 1
+		} else {
+			runs = append(runs, textRun{letter: letter, count: 1, start: pos, end: pos + 1})
+		}
+	}
+
+	return runs
+}
+
+// Check if a letter or digit comes after pos before the next space, so the symbols in "$h!t" and "B.@.D" are
+// part of a word and the ones ending "damn!" are not.
+func followedByLetter(runes []rune, pos int) bool {
+	for _, r := range runes[pos+1:] {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return true
+		} else if unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return false
+}
+
+// Return the normalized text as a string with one letter per run, used to compare messages.
+func normalizedString(text string) string {
+	var b strings.Builder
+	for _, run := range normalizeText(text) {
+		if run.letter == 0 {
+			b.WriteRune(' ')
+		} else {
+			b.WriteRune(run.letter)
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// WordFilter matches words from a word list. Only whole words match, words inside of other words are ignored.
+type WordFilter struct {
+	words  [][]textRun
+	action FilterAction
+}
+
+func newWordFilter(words []string, action FilterAction) *WordFilter {
+	f := &WordFilter{action: action}
+
+	for _, word := range words {
+		runs := normalizeText(word)
+		if len(runs) > 0 {
+			f.words = append(f.words, runs)
+		}
+	}
+
+	return f
+}
+
+// Filter masks or rejects text that contains a listed word.
+func (f *WordFilter) Filter(userID int, text string) FilterResult {
+	runs := normalizeText(text)
+	var masked []rune
+
+	for i := range runs {
+		if i > 0 && runs[i-1].letter != 0 {
+			continue
+		}
+
+		for _, word := range f.words {
+			end, ok := f.match(runs, i, word)
+			if !ok {
+				continue
+			}
+
+			if f.action != FilterMask {
+				return FilterResult{Action: f.action, Text: text, Reason: "text contains a blocked word"}
+			}
+
+			if masked == nil {
+				masked = []rune(text)
+			}
+			for pos := runs[i].start; pos < runs[end].end; pos++ {
+				if !unicode.IsSpace(masked[pos]) {
+					masked[pos] = '*'
+				}
+			}
+		}
+	}
+
+	if masked != nil {
+		return FilterResult{Action: FilterMask, Text: string(masked)}
+	}
+	return FilterResult{Action: FilterAllow, Text: text}
+}
+
+// Check if the word matches the text starting at run i. Return the index of the last run that matched.
+// A letter repeated in the text matches the letter of the word as long as the word does not repeat it more times.
+func (f *WordFilter) match(runs []textRun, i int, word []textRun) (int, bool) {
+	if i+len(word) > len(runs) {
+		return 0, false
+	}
+
+	for j, w := range word {
+		run := runs[i+j]
+		if run.letter != w.letter || run.count < w.count {
+			return 0, false
+		}
+	}
+
+	end := i + len(word) - 1
+	if end+1 < len(runs) && runs[end+1].letter != 0 {
+		return 0, false
+	}
+
+	return end, true
+}
+
+// Read a word list, one word per line. Blank lines and lines starting with # are skipped.
+// A missing file is an empty list.
+func loadWordList(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var words []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			words = append(words, line)
+		}
+	}
+
+	return words, scanner.Err()
+}
+
+// Matches URLs and bare domain names.
+var linkRegex = regexp.MustCompile(`(?i)\b(?:[a-z][a-z0-9+.-]*://\S+|www\.\S+|[a-z0-9-]+(?:\.[a-z0-9-]+)*\.(?:com|net|org|io|gg|co|me|tv|xyz|ru|info|biz|app|dev|ly)\b\S*)`)
+
+// LinkFilter matches links.
+type LinkFilter struct {
+	action FilterAction
+}
+
+// Filter masks or rejects text that contains a link.
+func (f *LinkFilter) Filter(userID int, text string) FilterResult {
+	if !linkRegex.MatchString(text) {
+		return FilterResult{Action: FilterAllow, Text: text}
+	}
+
+	if f.action != FilterMask {
+		return FilterResult{Action: f.action, Text: text, Reason: "links are not allowed"}
+	}
+
+	masked := linkRegex.ReplaceAllStringFunc(text, func(link string) string {
+		return strings.Repeat("*", utf8.RuneCountInString(link))
+	})
+	return FilterResult{Action: FilterMask, Text: masked}
+}
+
+// SpamFilter rejects a message that the user has already repeated limit times within a window of time.
+type SpamFilter struct {
+	limit     int
+	window    time.Duration
+	recent    map[int][]sentMessage // User ID to the messages they sent within the window
+	lastSweep time.Time             // Last time users with no messages within the window were removed
+	mut       sync.Mutex
+}
+
+// sentMessage is a normalized message and the time it was sent.
+type sentMessage struct {
+	text string
+	time time.Time
+}
+
+func newSpamFilter(limit int, window time.Duration) *SpamFilter {
+	return &SpamFilter{limit: limit, window: window, recent: make(map[int][]sentMessage), lastSweep: time.Now()}
+}
+
+// Filter rejects repeated messages.
+func (f *SpamFilter) Filter(userID int, text string) FilterResult {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	now := time.Now()
+	normalized := normalizedString(text)
+	f.sweep(now)
+
+	// Forget messages that are outside of the window
+	recent := f.recent[userID]
+	for len(recent) > 0 && now.Sub(recent[0].time) > f.window {
+		recent = recent[1:]
+	}
+
+	repeats := 0
+	for _, msg := range recent {
+		if msg.text == normalized {
+			repeats++
+		}
+	}
+
+	f.recent[userID] = append(recent, sentMessage{text: normalized, time: now})
+
+	if repeats > f.limit {
+		return FilterResult{Action: FilterReject, Text: text, Reason: "message has been repeated too many times"}
+	}
+	return FilterResult{Action: FilterAllow, Text: text}
+}
+
+// Remove the users whose last message is outside of the window, at most once per window. mut must be locked first.
+func (f *SpamFilter) sweep(now time.Time) {
+	if now.Sub(f.lastSweep) <= f.window {
+		return
+	}
+	f.lastSweep = now
+
+	for userID, recent := range f.recent {
+		if len(recent) == 0 || now.Sub(recent[len(recent)-1].time) > f.window {
+			delete(f.recent, userID)
+		}
+	}
+}
+
+// FloodFilter scores how fast each user is sending messages and mutes users whose score gets too high.
+// Each message adds to the score, longer messages add more, and the score decays over time.
+type FloodFilter struct {
+	threshold float64
+	decay     float64 // Score removed per second
+	scores    map[int]floodScore
+	lastSweep time.Time // Last time users whose score has decayed to zero were removed
+	mut       sync.Mutex
+}
+
+// floodScore is a user's score as of the last message they sent.
+type floodScore struct {
+	score float64
+	time  time.Time
+}
+
+func newFloodFilter(threshold, decay float64) *FloodFilter {
+	return &FloodFilter{threshold: threshold, decay: decay, scores: make(map[int]floodScore), lastSweep: time.Now()}
+}
+
+// Filter mutes users that are flooding.
+func (f *FloodFilter) Filter(userID int, text string) FilterResult {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	now := time.Now()
+	f.sweep(now)
+	last := f.scores[userID]
+
+	score := last.score - now.Sub(last.time).Seconds()*f.decay
+	if score < 0 {
+		score = 0
+	}
+	score += 1 + float64(utf8.RuneCountInString(text))/100
+
+	if score > f.threshold {
+		delete(f.scores, userID)
+		return FilterResult{Action: FilterMute, Text: text, Reason: "sending messages too fast"}
+	}
+
+	f.scores[userID] = floodScore{score: score, time: now}
+	return FilterResult{Action: FilterAllow, Text: text}
+}
+
+// Remove the users whose score has decayed to zero. Sweeps happen at most once in the time it takes the highest
+// score a user can have to decay. mut must be locked first.
+func (f *FloodFilter) sweep(now time.Time) {
+	if now.Sub(f.lastSweep).Seconds()*f.decay <= f.threshold {
+		return
+	}
+	f.lastSweep = now
+
+	for userID, last := range f.scores {
+		if last.score-now.Sub(last.time).Seconds()*f.decay <= 0 {
+			delete(f.scores, userID)
+		}
+	}
+}
+
+// Build the filter chains for chat messages and for names from the word lists in filterDir.
+func createFilters() (chat FilterChain, names FilterChain, err error) {
+	maskWords, err := loadWordList(filterDir + "/mask.txt")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rejectWords, err := loadWordList(filterDir + "/reject.txt")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chat, names = buildFilters(maskWords, rejectWords)
+	return chat, names, nil
+}
+
+// Build the filter chains from the word lists. Words in the mask list are masked in chat, words in the reject list
+// are rejected, and names are rejected if they contain either. Words only match whole words so names such as
+// "classic" or "scrap" are not rejected for the words inside them.
+func buildFilters(maskWords, rejectWords []string) (chat FilterChain, names FilterChain) {
+	chat = FilterChain{
+		newFloodFilter(floodThreshold, floodDecay),
+		newSpamFilter(spamRepeatLimit, spamWindow),
+		newWordFilter(rejectWords, FilterReject),
+		newWordFilter(maskWords, FilterMask),
+		&LinkFilter{action: FilterMask},
+	}
+
+	names = FilterChain{
+		newWordFilter(rejectWords, FilterReject),
+		newWordFilter(maskWords, FilterReject),
+		&LinkFilter{action: FilterReject},
+	}
+
+	return chat, names
+}
+
+// Run a chat message through the chat filter and return the text to send.
+// Users that the filter mutes are muted for floodMuteTime.
+func (s *Server) filterChat(userID int, text string) (string, error) {
+	result := s.chatFilter.Filter(userID, text)
+
+	switch result.Action {
+	case FilterReject:
+		return "", status.Errorf(codes.InvalidArgument, "message rejected: %v", result.Reason)
+
+	case FilterMute:
+		if err := muteUser(userID, time.Now().Add(floodMuteTime), s.pg); err != nil {
+			log.Println("mute user error:", err)
+		}
+		return "", status.Errorf(codes.PermissionDenied, "you have been muted: %v", result.Reason)
+	}
+
+	return result.Text, nil
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNormalizedString(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"empty", "", ""},
+		{"lowercase", "Hi There", "hi there"},
+		{"repeated letters", "baaad", "bad"},
+		{"leetspeak digits", "sh1t 4ss", "shit as"},
+		{"leetspeak symbols", "$h!t", "shit"},
+		{"separators", "b.a-d_w o r d", "badw o r d"},
+		{"separated leetspeak", "B.@.D", "bad"},
+		{"trailing symbols", "damn!! ok!", "damn ok"},
+		{"symbol before space", "wow! nice", "wow nice"},
+		{"extra spaces", "  spaced   out  ", "spaced out"},
+		{"unicode", "ÉCOLE", "école"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizedString(tt.text); got != tt.want {
+				t.Errorf("normalizedString(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordFilterMask(t *testing.T) {
+	filter := newWordFilter([]string{"crap", "damn"}, FilterMask)
+
+	tests := []struct {
+		name       string
+		text       string
+		wantAction FilterAction
+		wantText   string
+	}{
+		{"clean", "good game", FilterAllow, "good game"},
+		{"word", "what crap", FilterMask, "what ****"},
+		{"uppercase", "CRAP", FilterMask, "****"},
+		{"punctuation after", "damn! ok", FilterMask, "****! ok"},
+		{"repeated letters", "daaaamn", FilterMask, "*******"},
+		{"leetspeak", "cr4p", FilterMask, "****"},
+		{"separators", "d.a.m.n", FilterMask, "*******"},
+		{"several words", "crap and damn", FilterMask, "**** and ****"},
+		{"inside another word", "scrap metal", FilterAllow, "scrap metal"},
+		{"spaced letters", "c r a p", FilterAllow, "c r a p"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filter.Filter(1, tt.text)
+			if got.Action != tt.wantAction || got.Text != tt.wantText {
+				t.Errorf("Filter(%q) = %v %q, want %v %q", tt.text, got.Action, got.Text, tt.wantAction, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestNameFilter(t *testing.T) {
+	_, names := buildFilters([]string{"ass", "crap", "dick", "prick"}, []string{"nazi"})
+
+	tests := []struct {
+		name string
+		text string
+		want FilterAction
+	}{
+		{"clean", "granny", FilterAllow},
+		{"classic", "classic", FilterAllow},
+		{"cassandra", "Cassandra", FilterAllow},
+		{"glass", "glass", FilterAllow},
+		{"scrap", "scrap", FilterAllow},
+		{"prickly", "prickly", FilterAllow},
+		{"dickens", "Dickens Fans", FilterAllow},
+		{"mask word", "ass", FilterReject},
+		{"mask word in name", "big ass", FilterReject},
+		{"reject word", "nazi", FilterReject},
+		{"reject word leetspeak", "n4z1 club", FilterReject},
+		{"link", "join example.com", FilterReject},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := names.Filter(0, tt.text); got.Action != tt.want {
+				t.Errorf("Filter(%q) = %v, want %v", tt.text, got.Action, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpamFilter(t *testing.T) {
+	const limit = 2
+
+	tests := []struct {
+		name     string
+		messages []string
+		want     []FilterAction
+	}{
+		{"repeated up to the limit", []string{"hi", "hi", "hi", "hi"},
+			[]FilterAction{FilterAllow, FilterAllow, FilterAllow, FilterReject}},
+		{"normalized repeats", []string{"hello", "HELLO", "hello!!", "h.e.l.l.o"},
+			[]FilterAction{FilterAllow, FilterAllow, FilterAllow, FilterReject}},
+		{"other messages between", []string{"gg", "wp", "gg", "wp", "gg", "gg"},
+			[]FilterAction{FilterAllow, FilterAllow, FilterAllow, FilterAllow, FilterAllow, FilterReject}},
+		{"different messages", []string{"a", "b", "c", "d"},
+			[]FilterAction{FilterAllow, FilterAllow, FilterAllow, FilterAllow}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := newSpamFilter(limit, time.Minute)
+			for i, msg := range tt.messages {
+				if got := filter.Filter(1, msg); got.Action != tt.want[i] {
+					t.Errorf("message %v %q = %v, want %v", i, msg, got.Action, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSpamFilterWindow(t *testing.T) {
+	filter := newSpamFilter(1, time.Minute)
+	filter.Filter(1, "hi")
+	filter.Filter(1, "hi")
+
+	if got := filter.Filter(2, "hi"); got.Action != FilterAllow {
+		t.Errorf("another user's repeats counted, got %v", got.Action)
+	}
+
+	// Move the messages outside of the window
+	for i := range filter.recent[1] {
+		filter.recent[1][i].time = time.Now().Add(-2 * time.Minute)
+	}
+	if got := filter.Filter(1, "hi"); got.Action != FilterAllow {
+		t.Errorf("messages outside the window counted, got %v", got.Action)
+	}
+}
@@ -0,0 +1,607 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cdrpl/granny/server/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// guild-<guild id> is the name of a guild's chat channel, only members can use it.
+const guildChannelPrefix = "guild-"
+
+var (
+	errGuildFull = errors.New("Guild is full")
+	errInGuild   = errors.New("User is already in a guild")
+)
+
+// GuildAction is something a guild member can do to the guild.
+type GuildAction int
+
+// Guild actions that need a rank.
+const (
+	GuildInvite            GuildAction = iota // Invite users
+	GuildReviewApplication                    // Accept or decline applications
+	GuildKick                                 // Kick members of a lower rank
+	GuildSetMotd                              // Change the message of the day
+	GuildSetRank                              // Promote and demote members
+	GuildDisband                              // Delete the guild
+)
+
+// The lowest rank allowed to do each action.
+var guildPermissions = map[GuildAction]proto.GuildRank{
+	GuildInvite:            proto.GuildRank_GUILD_RANK_OFFICER,
+	GuildReviewApplication: proto.GuildRank_GUILD_RANK_OFFICER,
+	GuildKick:              proto.GuildRank_GUILD_RANK_OFFICER,
+	GuildSetMotd:           proto.GuildRank_GUILD_RANK_OFFICER,
+	GuildSetRank:           proto.GuildRank_GUILD_RANK_LEADER,
+	GuildDisband:           proto.GuildRank_GUILD_RANK_LEADER,
+}
+
+// Return the name of the guild's chat channel.
+func guildChannel(guildID int) string {
+	return guildChannelPrefix + strconv.Itoa(guildID)
+}
+
+// Return the ID of the guild that owns the chat channel, false is returned if it is not a guild channel.
+func guildChannelID(name string) (int, bool) {
+	if !strings.HasPrefix(name, guildChannelPrefix) {
+		return 0, false
+	}
+
+	id, err := strconv.Atoi(strings.TrimPrefix(name, guildChannelPrefix))
+	if err != nil || id <= 0 || guildChannel(id) != name {
+		return 0, false
+	}
+	return id, true
+}
+
+// CreateGuild will create a guild with the user as its leader.
+func (s *Server) CreateGuild(ctx context.Context, in *proto.CreateGuildReq) (*proto.CreateGuildRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	if err := validateCreateGuildRequest(in, s.nameFilter); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := s.guildMember(id); err == nil {
+		return nil, guildError("create guild error", errInGuild)
+	} else if status.Code(err) != codes.FailedPrecondition {
+		return nil, err
+	}
+
+	// Tag must be unique
+	tagExists, err := guildTagExists(in.Tag, s.pg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "query tag error")
+	} else if tagExists {
+		return nil, status.Error(codes.AlreadyExists, "tag already exists")
+	}
+
+	// Name must be unique
+	nameExists, err := guildNameExists(in.Name, s.pg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "query name error")
+	} else if nameExists {
+		return nil, status.Error(codes.AlreadyExists, "name already exists")
+	}
+
+	guildID, err := insertGuild(in.Tag, in.Name, id, s.pg)
+	if err != nil {
+		log.Println("insert guild error:", err)
+		return nil, status.Error(codes.Internal, "create guild error")
+	}
+
+	s.joinGuildChannel(id, guildID)
+
+	guild, err := findGuild(guildID, s.pg)
+	if err != nil || guild == nil {
+		log.Println("create guild find error:", err)
+		return nil, status.Error(codes.Internal, "create guild error")
+	}
+	guild.Channel = guildChannel(guildID)
+
+	return &proto.CreateGuildRes{Guild: guild}, nil
+}
+
+// GetGuild will return a guild and its members, the user's own guild is returned if no guild is given.
+// Only members see the message of the day, and only officers and the leader see invites and applications.
+func (s *Server) GetGuild(ctx context.Context, in *proto.GetGuildReq) (*proto.GetGuildRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	memberOf, rank, err := findGuildMember(id, s.pg)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "get guild error")
+	}
+
+	var guild *proto.GuildInfo
+	switch in.Guild.(type) {
+	case *proto.GetGuildReq_Id:
+		guild, err = findGuild(int(in.GetId()), s.pg)
+	case *proto.GetGuildReq_Tag:
+		guild, err = findGuildByTag(strings.ToUpper(govalidator.Trim(in.GetTag(), "")), s.pg)
+	default:
+		if memberOf == 0 {
+			return &proto.GetGuildRes{}, nil
+		}
+		guild, err = findGuild(memberOf, s.pg)
+	}
+
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "get guild error")
+	} else if guild == nil {
+		return nil, status.Error(codes.NotFound, "guild not found")
+	}
+
+	res := &proto.GetGuildRes{Guild: guild}
+
+	if res.Members, err = findGuildMembers(int(guild.Id), s.pg); err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "get guild error")
+	}
+
+	if int(guild.Id) != memberOf {
+		guild.Motd = ""
+		return res, nil
+	}
+
+	guild.Channel = guildChannel(memberOf)
+
+	if rank >= guildPermissions[GuildInvite] {
+		if res.Invites, err = findGuildInvitees(memberOf, s.pg); err != nil {
+			log.Println(err)
+			return nil, status.Error(codes.Internal, "get guild error")
+		}
+	}
+
+	if rank >= guildPermissions[GuildReviewApplication] {
+		if res.Applications, err = findGuildApplicants(memberOf, s.pg); err != nil {
+			log.Println(err)
+			return nil, status.Error(codes.Internal, "get guild error")
+		}
+	}
+
+	return res, nil
+}
+
+// InviteToGuild will invite a user to the user's guild.
+func (s *Server) InviteToGuild(ctx context.Context, in *proto.InviteToGuildReq) (*proto.InviteToGuildRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	guildID, err := s.checkGuildPermission(id, GuildInvite)
+	if err != nil {
+		return nil, err
+	}
+
+	invitedID, err := s.resolveUser(in.GetUserId(), in.GetName())
+	if err != nil {
+		return nil, err
+	} else if invitedID == id {
+		return nil, status.Error(codes.InvalidArgument, "can not invite yourself")
+	}
+
+	if _, err := findUser(invitedID, s.pg); err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	if err := s.checkNotBlocked(id, invitedID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.guildMember(invitedID); err == nil {
+		return nil, guildError("invite to guild error", errInGuild)
+	} else if status.Code(err) != codes.FailedPrecondition {
+		return nil, err
+	}
+
+	invited, err := insertGuildInvite(guildID, invitedID, s.pg)
+	if err != nil {
+		log.Println("insert guild invite error:", err)
+		return nil, status.Error(codes.Internal, "invite to guild error")
+	} else if !invited {
+		return nil, status.Error(codes.AlreadyExists, "user has already been invited")
+	}
+
+	return &proto.InviteToGuildRes{}, nil
+}
+
+// ListGuildInvites will return the guilds that have invited the user.
+func (s *Server) ListGuildInvites(ctx context.Context, in *proto.ListGuildInvitesReq) (*proto.ListGuildInvitesRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	guilds, err := findUserGuildInvites(id, s.pg)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "list guild invites error")
+	}
+
+	return &proto.ListGuildInvitesRes{Guilds: guilds}, nil
+}
+
+// AcceptGuildInvite will add the user to a guild that invited them.
+func (s *Server) AcceptGuildInvite(ctx context.Context, in *proto.AcceptGuildInviteReq) (*proto.AcceptGuildInviteRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	if _, err := s.guildMember(id); err == nil {
+		return nil, guildError("accept guild invite error", errInGuild)
+	} else if status.Code(err) != codes.FailedPrecondition {
+		return nil, err
+	}
+
+	added, err := addGuildMember(int(in.GuildId), id, guildInvite, s.pg)
+	if err != nil {
+		return nil, guildError("accept guild invite error", err)
+	} else if !added {
+		return nil, status.Error(codes.NotFound, "guild invite not found")
+	}
+
+	s.joinGuildChannel(id, int(in.GuildId))
+
+	guild, err := findGuild(int(in.GuildId), s.pg)
+	if err != nil || guild == nil {
+		log.Println("accept guild invite find error:", err)
+		return nil, status.Error(codes.Internal, "accept guild invite error")
+	}
+	guild.Channel = guildChannel(int(in.GuildId))
+
+	return &proto.AcceptGuildInviteRes{Guild: guild}, nil
+}
+
+// DeclineGuildInvite will remove a guild invite.
+func (s *Server) DeclineGuildInvite(ctx context.Context, in *proto.DeclineGuildInviteReq) (*proto.DeclineGuildInviteRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	deleted, err := deleteGuildInvite(int(in.GuildId), id, s.pg)
+	if err != nil {
+		log.Println("delete guild invite error:", err)
+		return nil, status.Error(codes.Internal, "decline guild invite error")
+	} else if !deleted {
+		return nil, status.Error(codes.NotFound, "guild invite not found")
+	}
+
+	return &proto.DeclineGuildInviteRes{}, nil
+}
+
+// ApplyToGuild will ask to join a guild, officers and the leader can accept the application.
+func (s *Server) ApplyToGuild(ctx context.Context, in *proto.ApplyToGuildReq) (*proto.ApplyToGuildRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	if _, err := s.guildMember(id); err == nil {
+		return nil, guildError("apply to guild error", errInGuild)
+	} else if status.Code(err) != codes.FailedPrecondition {
+		return nil, err
+	}
+
+	var guild *proto.GuildInfo
+	var err error
+	if tag, ok := in.Guild.(*proto.ApplyToGuildReq_Tag); ok {
+		guild, err = findGuildByTag(strings.ToUpper(govalidator.Trim(tag.Tag, "")), s.pg)
+	} else {
+		guild, err = findGuild(int(in.GetId()), s.pg)
+	}
+
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "apply to guild error")
+	} else if guild == nil {
+		return nil, status.Error(codes.NotFound, "guild not found")
+	} else if guild.Members >= maxGuildMembers {
+		return nil, guildError("apply to guild error", errGuildFull)
+	}
+
+	applied, err := insertGuildApplication(int(guild.Id), id, s.pg)
+	if err != nil {
+		log.Println("insert guild application error:", err)
+		return nil, status.Error(codes.Internal, "apply to guild error")
+	} else if !applied {
+		return nil, status.Error(codes.AlreadyExists, "already applied to guild")
+	}
+
+	return &proto.ApplyToGuildRes{}, nil
+}
+
+// AcceptGuildApplication will add a user that applied to the guild as a member.
+func (s *Server) AcceptGuildApplication(ctx context.Context, in *proto.AcceptGuildApplicationReq) (*proto.AcceptGuildApplicationRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	guildID, err := s.checkGuildPermission(id, GuildReviewApplication)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := addGuildMember(guildID, int(in.UserId), guildApplication, s.pg)
+	if err != nil {
+		return nil, guildError("accept guild application error", err)
+	} else if !added {
+		return nil, status.Error(codes.NotFound, "guild application not found")
+	}
+
+	s.joinGuildChannel(int(in.UserId), guildID)
+	return &proto.AcceptGuildApplicationRes{}, nil
+}
+
+// DeclineGuildApplication will remove an application to the guild.
+func (s *Server) DeclineGuildApplication(ctx context.Context, in *proto.DeclineGuildApplicationReq) (*proto.DeclineGuildApplicationRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	guildID, err := s.checkGuildPermission(id, GuildReviewApplication)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := deleteGuildApplication(guildID, int(in.UserId), s.pg)
+	if err != nil {
+		log.Println("delete guild application error:", err)
+		return nil, status.Error(codes.Internal, "decline guild application error")
+	} else if !deleted {
+		return nil, status.Error(codes.NotFound, "guild application not found")
+	}
+
+	return &proto.DeclineGuildApplicationRes{}, nil
+}
+
+// LeaveGuild will remove the user from their guild.
+// The leader has to make another member the leader first, a leader that is the last member disbands the guild.
+func (s *Server) LeaveGuild(ctx context.Context, in *proto.LeaveGuildReq) (*proto.LeaveGuildRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	member, err := s.guildMember(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if member.Rank == proto.GuildRank_GUILD_RANK_LEADER {
+		guild, err := findGuild(member.GuildID, s.pg)
+		if err != nil || guild == nil {
+			log.Println("leave guild find error:", err)
+			return nil, status.Error(codes.Internal, "leave guild error")
+		} else if guild.Members > 1 {
+			return nil, status.Error(codes.FailedPrecondition, "the leader must make another member the leader before leaving")
+		}
+
+		if err := deleteGuild(member.GuildID, s.pg); err != nil {
+			log.Println("delete guild error:", err)
+			return nil, status.Error(codes.Internal, "leave guild error")
+		}
+	} else {
+		if _, err := deleteGuildMember(member.GuildID, id, s.pg); err != nil {
+			log.Println("delete guild member error:", err)
+			return nil, status.Error(codes.Internal, "leave guild error")
+		}
+	}
+
+	s.leaveGuildChannel(id, member.GuildID)
+	return &proto.LeaveGuildRes{}, nil
+}
+
+// KickGuildMember will remove a member of a lower rank from the guild.
+func (s *Server) KickGuildMember(ctx context.Context, in *proto.KickGuildMemberReq) (*proto.KickGuildMemberRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	guildID, err := s.checkGuildPermission(id, GuildKick)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkOutranks(id, int(in.UserId), guildID); err != nil {
+		return nil, err
+	}
+
+	kicked, err := deleteGuildMember(guildID, int(in.UserId), s.pg)
+	if err != nil {
+		log.Println("delete guild member error:", err)
+		return nil, status.Error(codes.Internal, "kick guild member error")
+	} else if !kicked {
+		return nil, status.Error(codes.NotFound, "user is not in the guild")
+	}
+
+	s.leaveGuildChannel(int(in.UserId), guildID)
+	return &proto.KickGuildMemberRes{}, nil
+}
+
+// SetGuildRank will change the rank of a member, making a member the leader makes the current leader an officer.
+func (s *Server) SetGuildRank(ctx context.Context, in *proto.SetGuildRankReq) (*proto.SetGuildRankRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	if _, ok := proto.GuildRank_name[int32(in.Rank)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "rank is not valid")
+	}
+
+	guildID, err := s.checkGuildPermission(id, GuildSetRank)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkOutranks(id, int(in.UserId), guildID); err != nil {
+		return nil, err
+	}
+
+	var changed bool
+	if in.Rank == proto.GuildRank_GUILD_RANK_LEADER {
+		changed, err = transferGuildLeader(guildID, id, int(in.UserId), s.pg)
+	} else {
+		changed, err = updateGuildRank(guildID, int(in.UserId), in.Rank, s.pg)
+	}
+
+	if err != nil {
+		log.Println("set guild rank error:", err)
+		return nil, status.Error(codes.Internal, "set guild rank error")
+	} else if !changed {
+		return nil, status.Error(codes.NotFound, "user is not in the guild")
+	}
+
+	return &proto.SetGuildRankRes{}, nil
+}
+
+// SetGuildMotd will change the guild's message of the day, an empty message clears it.
+func (s *Server) SetGuildMotd(ctx context.Context, in *proto.SetGuildMotdReq) (*proto.SetGuildMotdRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	in.Motd = govalidator.Trim(in.Motd, "")
+	if utf8.RuneCountInString(in.Motd) > maxChatLength {
+		return nil, status.Errorf(codes.InvalidArgument, "message of the day must be at most %d characters", maxChatLength)
+	}
+
+	guildID, err := s.checkGuildPermission(id, GuildSetMotd)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Motd != "" {
+		if in.Motd, err = s.filterChat(id, in.Motd); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := updateGuildMotd(guildID, in.Motd, s.pg); err != nil {
+		log.Println("update guild motd error:", err)
+		return nil, status.Error(codes.Internal, "set guild motd error")
+	}
+
+	return &proto.SetGuildMotdRes{}, nil
+}
+
+// DisbandGuild will delete the guild and remove every member.
+func (s *Server) DisbandGuild(ctx context.Context, in *proto.DisbandGuildReq) (*proto.DisbandGuildRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	guildID, err := s.checkGuildPermission(id, GuildDisband)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := findGuildMembers(guildID, s.pg)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "disband guild error")
+	}
+
+	if err := deleteGuild(guildID, s.pg); err != nil {
+		log.Println("delete guild error:", err)
+		return nil, status.Error(codes.Internal, "disband guild error")
+	}
+
+	for _, member := range members {
+		s.leaveGuildChannel(int(member.User.Id), guildID)
+	}
+
+	return &proto.DisbandGuildRes{}, nil
+}
+
+// GuildMembership is the guild a user is in and their rank.
+type GuildMembership struct {
+	GuildID int
+	Rank    proto.GuildRank
+}
+
+// Return the user's guild membership, FailedPrecondition is returned if the user is not in a guild.
+func (s *Server) guildMember(userID int) (GuildMembership, error) {
+	guildID, rank, err := findGuildMember(userID, s.pg)
+	if err != nil {
+		log.Println(err)
+		return GuildMembership{}, status.Error(codes.Internal, "find guild member error")
+	} else if guildID == 0 {
+		return GuildMembership{}, status.Error(codes.FailedPrecondition, "not in a guild")
+	}
+
+	return GuildMembership{GuildID: guildID, Rank: rank}, nil
+}
+
+// Check the user's rank allows the action and return the ID of their guild.
+func (s *Server) checkGuildPermission(userID int, action GuildAction) (int, error) {
+	member, err := s.guildMember(userID)
+	if err != nil {
+		return 0, err
+	} else if member.Rank < guildPermissions[action] {
+		return 0, status.Error(codes.PermissionDenied, "guild rank is too low")
+	}
+
+	return member.GuildID, nil
+}
+
+// Check the other user is in the guild and has a lower rank than the user.
+func (s *Server) checkOutranks(userID, otherID, guildID int) error {
+	if otherID == userID {
+		return status.Error(codes.InvalidArgument, "can not change yourself")
+	}
+
+	_, rank, err := findGuildMember(userID, s.pg)
+	if err != nil {
+		log.Println(err)
+		return status.Error(codes.Internal, "find guild member error")
+	}
+
+	otherGuildID, otherRank, err := findGuildMember(otherID, s.pg)
+	if err != nil {
+		log.Println(err)
+		return status.Error(codes.Internal, "find guild member error")
+	} else if otherGuildID != guildID {
+		return status.Error(codes.NotFound, "user is not in the guild")
+	} else if otherRank >= rank {
+		return status.Error(codes.PermissionDenied, "user does not have a lower rank")
+	}
+
+	return nil
+}
+
+// Add the guild's chat channel to the member's joined channels.
+func (s *Server) joinGuildChannel(userID, guildID int) {
+	if err := s.chat.joinChannel(userID, guildChannel(guildID)); err != nil {
+		log.Println("join guild channel error:", err)
+	}
+}
+
+// Remove the guild's chat channel from the user's joined channels.
+func (s *Server) leaveGuildChannel(userID, guildID int) {
+	if err := s.chat.leaveChannel(userID, guildChannel(guildID)); err != nil {
+		log.Println("leave guild channel error:", err)
+	}
+}
+
+// Return an error with a status code that matches the guild error.
+func guildError(msg string, err error) error {
+	switch err {
+	case errInGuild:
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	case errGuildFull:
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+
+	log.Println(msg+":", err)
+	return status.Error(codes.Internal, msg)
+}
+
+// CreateGuildValidator is used to validate create guild requests.
+type CreateGuildValidator struct {
+	Tag  string `valid:"required,alphanum,stringlength(2|5)"`
+	Name string `valid:"required,stringlength(3|32)"`
+}
+
+// Sanitize and validate the create guild request. Tags are uppercase.
+func validateCreateGuildRequest(req *proto.CreateGuildReq, filter TextFilter) error {
+	req.Tag = strings.ToUpper(govalidator.Trim(req.Tag, ""))
+	req.Name = govalidator.Trim(req.Name, "")
+
+	v := CreateGuildValidator{Tag: req.Tag, Name: req.Name}
+	_, err := govalidator.ValidateStruct(v)
+	if err != nil {
+		return err
+	}
+
+	if filter.Filter(0, req.Tag).Action != FilterAllow {
+		return errors.New("tag is not allowed")
+	}
+	if filter.Filter(0, req.Name).Action != FilterAllow {
+		return errors.New("name is not allowed")
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package main
+
+import "testing"
+
+func TestGuildChannelID(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     string
+		wantID int
+		wantOK bool
+	}{
+		{"guild channel", guildChannel(42), 42, true},
+		{"global channel", "global", 0, false},
+		{"no id", guildChannelPrefix, 0, false},
+		{"not a number", guildChannelPrefix + "abc", 0, false},
+		{"zero", guildChannelPrefix + "0", 0, false},
+		{"negative", guildChannelPrefix + "-3", 0, false},
+		{"leading zero", guildChannelPrefix + "042", 0, false},
+		{"prefix in the middle", "my-" + guildChannel(42), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := guildChannelID(tt.in)
+			if id != tt.wantID || ok != tt.wantOK {
+				t.Errorf("guildChannelID(%q) = %v, %v, want %v, %v", tt.in, id, ok, tt.wantID, tt.wantOK)
+			}
+		})
+	}
+}
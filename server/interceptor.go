@@ -30,8 +30,11 @@ func (u *UnaryInterceptor) auth(ctx context.Context, req interface{}, info *grpc
 }
 
 // StreamInterceptor for authentication.
+// Users are online while they have a stream open, streams forwarded by another instance are not counted.
 type StreamInterceptor struct {
-	rdb *redis.Client
+	rdb    *redis.Client
+	dir    *Directory
+	social *SocialHub
 }
 
 func (s *StreamInterceptor) auth(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
@@ -40,6 +43,13 @@ func (s *StreamInterceptor) auth(srv interface{}, stream grpc.ServerStream, info
 		return err
 	}
 
+	// Chunk downloads are short lived and do not make a user online
+	if !s.dir.forwardedByInstance(stream.Context()) && info.FullMethod != "/proto.World/GetChunk" {
+		id, _, _ := extractUserIDAndToken(stream.Context())
+		s.social.connect(id)
+		defer s.social.disconnect(id)
+	}
+
 	return handler(srv, stream)
 }
 
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"sort"
+)
+
+// Return the IDs of the entities the user can see, including their own entity. Entities come into view within
+// viewRadius and stay in view until they are further than viewRadius plus viewMargin, so entities on the edge
+// do not flicker in and out of view. previous is the user's last view.
+// Entities out of view are never sent to the user, which saves bandwidth and stops clients from revealing them.
+func (sim *Simulation) view(userID int, previous map[int]bool) map[int]bool {
+	view := make(map[int]bool)
+
+	pos, ok := sim.grid.position(userID)
+	if !ok {
+		return view
+	}
+
+	for _, id := range sim.grid.queryRadius(pos, viewRadius+viewMargin) {
+		other, _ := sim.grid.position(id)
+		if id == userID || previous[id] || vectorDist(pos, other) <= viewRadius {
+			view[id] = true
+		}
+	}
+
+	return view
+}
+
+// Return the IDs of the entities that entered and left the view since the previous view, sorted by ID.
+func viewChanges(previous, view map[int]bool) (entered []int32, left []int32) {
+	for id := range view {
+		if !previous[id] {
+			entered = append(entered, int32(id))
+		}
+	}
+
+	for id := range previous {
+		if !view[id] {
+			left = append(left, int32(id))
+		}
+	}
+
+	sort.Slice(entered, func(i, j int) bool { return entered[i] < entered[j] })
+	sort.Slice(left, func(i, j int) bool { return left[i] < left[j] })
+	return entered, left
+}
+
+// Record the view of the snapshot sent at the tick. Views older than maxBaselineAge ticks can no longer be baselines.
+func (c *snapshotClient) setView(tick int64, view map[int]bool) {
+	c.view = view
+	c.views[tick] = view
+
+	for t := range c.views {
+		if t <= tick-maxBaselineAge {
+			delete(c.views, t)
+		}
+	}
+}
@@ -0,0 +1,408 @@
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cdrpl/granny/server/proto"
+)
+
+// Lobby holds every room owned by this server instance and tracks which room each user is in.
+// Rooms and users are also recorded in the directory so other instances can find them.
+type Lobby struct {
+	rooms     map[string]*Room
+	userRooms map[int]string // User ID to room ID
+	dir       *Directory
+	tickRate  int      // Simulation ticks per second of every room
+	world     *TileMap // Map every room's game is played on
+	mut       sync.Mutex
+}
+
+func newLobby(dir *Directory, tickRate int, world *TileMap) Lobby {
+	return Lobby{
+		rooms:     make(map[string]*Room),
+		userRooms: make(map[int]string),
+		dir:       dir,
+		tickRate:  tickRate,
+		world:     world,
+	}
+}
+
+// Create a new room and add it to the lobby.
+func (l *Lobby) createRoom(settings RoomSettings) (*Room, error) {
+	id, err := generateToken(roomIDBytes)
+	if err != nil {
+		return nil, fmt.Errorf("generate room id error: %v", err)
+	}
+
+	room := newRoom(id, settings)
+	if err := l.dir.registerRoom(room.info()); err != nil {
+		return nil, fmt.Errorf("register room error: %v", err)
+	}
+
+	l.addRoom(room)
+	return room, nil
+}
+
+// Add the room to the lobby, room events and snapshots are published to every instance.
+func (l *Lobby) addRoom(room *Room) {
+	room.tickRate = l.tickRate
+	room.world = l.world
+	room.onEvent = func(event *proto.RoomEvent, info *proto.RoomInfo) {
+		if err := l.dir.publishEvent(event, info); err != nil {
+			log.Println("queue room event error:", err)
+		}
+	}
+	room.onSnapshot = func(event *proto.RoomEvent) {
+		if err := l.dir.publishSnapshot(room.id, event); err != nil {
+			log.Println("queue snapshot error:", err)
+		}
+	}
+
+	l.mut.Lock()
+	l.rooms[room.id] = room
+	l.mut.Unlock()
+}
+
+func (l *Lobby) getRoom(id string) *Room {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	return l.rooms[id]
+}
+
+// Remove a room that was never joined.
+func (l *Lobby) removeRoom(id string) {
+	l.mut.Lock()
+	room, ok := l.rooms[id]
+	if ok {
+		l.closeRoom(room)
+	}
+	l.mut.Unlock()
+
+	if ok {
+		l.unregisterRoom(id)
+	}
+}
+
+// Return the room the user is in or nil if the user is not in a room on this instance.
+func (l *Lobby) getUserRoom(userID int) *Room {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	return l.rooms[l.userRooms[userID]]
+}
+
+// Add every user to the room with the given ID or none of them. Users are claimed in the directory before mut is
+// locked so a slow directory does not hold up the rest of the lobby.
+func (l *Lobby) joinUsers(roomID string, users []*RoomUser) (*Room, error) {
+	if l.getRoom(roomID) == nil {
+		return nil, errors.New("Room does not exist")
+	}
+
+	for i, user := range users {
+		claimed, err := l.dir.claimUser(user.id, roomID)
+		if err != nil || !claimed {
+			l.releaseUsers(users[:i])
+			if err != nil {
+				log.Println("claim user error:", err)
+				return nil, errors.New("Could not join the room")
+			}
+			return nil, errors.New("User is already in a room")
+		}
+	}
+
+	room, err := l.addUsers(roomID, users)
+	if err != nil {
+		l.releaseUsers(users)
+		return nil, err
+	}
+	return room, nil
+}
+
+// Add the claimed users to the room, the room may have closed while they were being claimed.
+func (l *Lobby) addUsers(roomID string, users []*RoomUser) (*Room, error) {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	room, ok := l.rooms[roomID]
+	if !ok {
+		return nil, errors.New("Room does not exist")
+	}
+
+	if err := room.joinUsers(users); err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		l.userRooms[user.id] = roomID
+	}
+	return room, nil
+}
+
+// Remove the user from their room, the room is removed once it is empty.
+func (l *Lobby) leaveRoom(userID int) error {
+	l.mut.Lock()
+	roomID, ok := l.userRooms[userID]
+	if !ok {
+		l.mut.Unlock()
+		return errors.New("User is not in a room")
+	}
+
+	room := l.rooms[roomID]
+	empty, err := room.leaveRoom(userID)
+	if err != nil {
+		l.mut.Unlock()
+		return err
+	}
+
+	delete(l.userRooms, userID)
+	if empty {
+		l.closeRoom(room)
+	}
+	l.mut.Unlock()
+
+	l.releaseUser(userID)
+	if empty {
+		l.unregisterRoom(roomID)
+	}
+	return nil
+}
+
+// Mark the user as disconnected, they are removed from the room if they do not reconnect within the grace period.
+func (l *Lobby) disconnect(userID int, events chan *proto.RoomEvent) {
+	room := l.getUserRoom(userID)
+	if room == nil {
+		return
+	}
+
+	room.disconnect(userID, events, func(disconnects int) {
+		l.evictUser(room, userID, disconnects)
+	})
+}
+
+// Remove a user that did not reconnect in time, the room is removed once it is empty.
+func (l *Lobby) evictUser(room *Room, userID int, disconnects int) {
+	l.mut.Lock()
+	empty, err := room.evictUser(userID, disconnects)
+	if err != nil {
+		l.mut.Unlock()
+		return
+	}
+
+	delete(l.userRooms, userID)
+	if empty {
+		l.closeRoom(room)
+	}
+	l.mut.Unlock()
+
+	l.releaseUser(userID)
+	if empty {
+		l.unregisterRoom(room.id)
+	}
+}
+
+// Kick a user from the host's room.
+func (l *Lobby) kickUser(hostID, userID int) error {
+	l.mut.Lock()
+	roomID, ok := l.userRooms[hostID]
+	if !ok {
+		l.mut.Unlock()
+		return errors.New("User is not in a room")
+	}
+
+	if err := l.rooms[roomID].kickUser(hostID, userID); err != nil {
+		l.mut.Unlock()
+		return err
+	}
+
+	delete(l.userRooms, userID)
+	l.mut.Unlock()
+
+	l.releaseUser(userID)
+	return nil
+}
+
+// Remove the user from the directory, mut must not be locked so the lobby does not wait on the directory.
+func (l *Lobby) releaseUser(userID int) {
+	if err := l.dir.releaseUser(userID); err != nil {
+		log.Println("release user error:", err)
+	}
+}
+
+// Remove every user from the directory, mut must not be locked.
+func (l *Lobby) releaseUsers(users []*RoomUser) {
+	for _, user := range users {
+		l.releaseUser(user.id)
+	}
+}
+
+// Remove an empty room from the lobby and end its event streams, mut must be locked first. The room must then be
+// removed from the directory with unregisterRoom once mut is unlocked.
+func (l *Lobby) closeRoom(room *Room) {
+	delete(l.rooms, room.id)
+
+	room.mut.Lock()
+	room.close()
+	room.mut.Unlock()
+}
+
+// Remove a closed room from the directory, mut must not be locked.
+func (l *Lobby) unregisterRoom(id string) {
+	if err := l.dir.unregisterRoom(id); err != nil {
+		log.Println("unregister room error:", err)
+	}
+}
+
+// Return the oldest public room that is open and has space for the given number of users, or nil if there are none.
+// Rooms in exclude are skipped.
+func findOpenRoom(infos []*proto.RoomInfo, seats int, exclude map[string]bool) *proto.RoomInfo {
+	sortRoomInfos(infos)
+
+	filter := RoomFilter{NotFull: true, States: []proto.RoomState{proto.RoomState_ROOM_STATE_OPEN}}
+	for _, info := range infos {
+		if filter.match(info) && info.Users+int32(seats) <= info.Capacity && !exclude[info.Id] {
+			return info
+		}
+	}
+
+	return nil
+}
+
+// Order rooms by creation time, ties are broken by room ID.
+func sortRoomInfos(infos []*proto.RoomInfo) {
+	sort.Slice(infos, func(i, j int) bool {
+		return roomAfter(infos[j], infos[i].CreatedAt, infos[i].Id)
+	})
+}
+
+// RoomFilter narrows down the rooms returned by a room listing.
+type RoomFilter struct {
+	NotFull bool
+	States  []proto.RoomState
+	Tag     string
+}
+
+// Check if the room info passes the filter.
+func (f RoomFilter) match(info *proto.RoomInfo) bool {
+	if !info.Public {
+		return false
+	}
+
+	if f.NotFull && info.Users >= info.Capacity {
+		return false
+	}
+
+	if len(f.States) > 0 {
+		found := false
+		for _, state := range f.States {
+			if info.State == state {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if f.Tag != "" {
+		found := false
+		for _, tag := range info.Tags {
+			if tag == f.Tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// List the public rooms that pass the filter, starting after the cursor.
+// Return value (rooms, next cursor, err), the next cursor is empty if there are no more rooms.
+func listRooms(infos []*proto.RoomInfo, filter RoomFilter, cursor string, limit int) ([]*proto.RoomInfo, string, error) {
+	var afterTime int64
+	var afterID string
+
+	if cursor != "" {
+		var err error
+		afterTime, afterID, err = decodeRoomCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	sortRoomInfos(infos)
+
+	list := make([]*proto.RoomInfo, 0, limit)
+
+	for _, info := range infos {
+		if cursor != "" && !roomAfter(info, afterTime, afterID) {
+			continue
+		}
+
+		if !filter.match(info) {
+			continue
+		}
+
+		// Another matching room exists so there is a next page
+		if len(list) == limit {
+			return list, encodeRoomCursor(list[limit-1]), nil
+		}
+
+		list = append(list, info)
+	}
+
+	return list, "", nil
+}
+
+// Return the number of rooms on a listing page, the default is used if no limit is given and limits are capped.
+func roomPageLimit(limit int32) int {
+	if limit <= 0 {
+		return roomsPerPage
+	} else if limit > maxRoomsPage {
+		return maxRoomsPage
+	}
+	return int(limit)
+}
+
+// Check if the room comes after the given creation time and room ID in listing order.
+func roomAfter(info *proto.RoomInfo, createdAt int64, id string) bool {
+	return info.CreatedAt > createdAt || (info.CreatedAt == createdAt && info.Id > id)
+}
+
+// Cursors are the creation time and ID of the last room on a page.
+func encodeRoomCursor(info *proto.RoomInfo) string {
+	raw := fmt.Sprintf("%d:%s", info.CreatedAt, info.Id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeRoomCursor(cursor string) (createdAt int64, id string, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return createdAt, id, errors.New("invalid cursor")
+	}
+
+	split := strings.SplitN(string(raw), ":", 2)
+	if len(split) != 2 {
+		return createdAt, id, errors.New("invalid cursor")
+	}
+
+	createdAt, err = strconv.ParseInt(split[0], 10, 64)
+	if err != nil {
+		return createdAt, id, errors.New("invalid cursor")
+	}
+
+	id = split[1]
+	return
+}
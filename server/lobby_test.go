@@ -0,0 +1,225 @@
+package main
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/cdrpl/granny/server/proto"
+)
+
+// Rooms in listing order, b and c were created at the same time so they are ordered by ID.
+func testRoomInfos() []*proto.RoomInfo {
+	open, inProgress := proto.RoomState_ROOM_STATE_OPEN, proto.RoomState_ROOM_STATE_IN_PROGRESS
+	return []*proto.RoomInfo{
+		{Id: "a", CreatedAt: 100, Public: true, Users: 1, Capacity: 4, State: open, Tags: []string{"casual"}},
+		{Id: "b", CreatedAt: 200, Public: true, Users: 4, Capacity: 4, State: open},
+		{Id: "c", CreatedAt: 200, Public: true, Users: 2, Capacity: 4, State: inProgress, Tags: []string{"ranked"}},
+		{Id: "d", CreatedAt: 300, Public: false, Users: 1, Capacity: 4, State: open},
+		{Id: "e", CreatedAt: 400, Public: true, Users: 3, Capacity: 4, State: open, Tags: []string{"casual", "ranked"}},
+		{Id: "f", CreatedAt: 500, Public: true, Users: 1, Capacity: 2, State: open},
+	}
+}
+
+// Return the IDs of the rooms.
+func roomIDs(infos []*proto.RoomInfo) []string {
+	ids := make([]string, len(infos))
+	for i, info := range infos {
+		ids[i] = info.Id
+	}
+	return ids
+}
+
+// Return the rooms in a random order, the directory returns rooms in hash order.
+func shuffledRooms(infos []*proto.RoomInfo, rnd *rand.Rand) []*proto.RoomInfo {
+	shuffled := append([]*proto.RoomInfo(nil), infos...)
+	rnd.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	return shuffled
+}
+
+func TestListRoomsPages(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	tests := []struct {
+		name      string
+		filter    RoomFilter
+		limit     int
+		wantPages [][]string
+	}{
+		{"one page", RoomFilter{}, 10, [][]string{{"a", "b", "c", "e", "f"}}},
+		{"exact page", RoomFilter{}, 5, [][]string{{"a", "b", "c", "e", "f"}}},
+		{"one per page", RoomFilter{}, 1, [][]string{{"a"}, {"b"}, {"c"}, {"e"}, {"f"}}},
+		{"page ends between rooms created together", RoomFilter{}, 2, [][]string{{"a", "b"}, {"c", "e"}, {"f"}}},
+		{"filtered", RoomFilter{NotFull: true}, 2, [][]string{{"a", "c"}, {"e", "f"}}},
+		{"no rooms match", RoomFilter{Tag: "missing"}, 2, [][]string{{}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			infos := testRoomInfos()
+
+			cursor := ""
+			for i, want := range tt.wantPages {
+				// Every page is listed from a different order to check the order does not depend on the directory
+				rooms, next, err := listRooms(shuffledRooms(infos, rnd), tt.filter, cursor, tt.limit)
+				if err != nil {
+					t.Fatalf("page %v error = %v", i, err)
+				}
+				if got := roomIDs(rooms); !reflect.DeepEqual(got, want) {
+					t.Errorf("page %v = %v, want %v", i, got, want)
+				}
+
+				last := i == len(tt.wantPages)-1
+				if last && next != "" {
+					t.Errorf("last page has next cursor %q, want none", next)
+				} else if !last && next == "" {
+					t.Fatalf("page %v has no next cursor", i)
+				}
+				cursor = next
+			}
+		})
+	}
+}
+
+// Rooms created or removed between pages do not move the rooms on later pages.
+func TestListRoomsCursorStable(t *testing.T) {
+	infos := testRoomInfos()
+
+	first, cursor, err := listRooms(infos, RoomFilter{}, "", 2)
+	if err != nil {
+		t.Fatalf("listRooms() error = %v", err)
+	}
+	if got := roomIDs(first); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Fatalf("first page = %v, want [a b]", got)
+	}
+
+	// Both rooms on the first page close and a new room is created
+	changed := append([]*proto.RoomInfo{{Id: "g", CreatedAt: 600, Public: true, Capacity: 4}}, infos[2:]...)
+
+	second, _, err := listRooms(changed, RoomFilter{}, cursor, 2)
+	if err != nil {
+		t.Fatalf("listRooms() error = %v", err)
+	}
+	if got := roomIDs(second); !reflect.DeepEqual(got, []string{"c", "e"}) {
+		t.Errorf("second page = %v, want [c e]", got)
+	}
+}
+
+func TestListRoomsCursor(t *testing.T) {
+	infos := testRoomInfos()
+
+	tests := []struct {
+		name    string
+		cursor  string
+		wantIDs []string
+		wantErr bool
+	}{
+		{"start", "", []string{"a", "b", "c", "e", "f"}, false},
+		{"after tie", encodeRoomCursor(infos[1]), []string{"c", "e", "f"}, false},
+		{"after private room", encodeRoomCursor(infos[3]), []string{"e", "f"}, false},
+		{"end of list", encodeRoomCursor(infos[5]), []string{}, false},
+		{"past end of list", encodeRoomCursor(&proto.RoomInfo{Id: "z", CreatedAt: 1000}), []string{}, false},
+		{"room that no longer exists", encodeRoomCursor(&proto.RoomInfo{Id: "x", CreatedAt: 250}), []string{"e", "f"}, false},
+		{"not base64", "!!!", nil, true},
+		{"no separator", "MTIz", nil, true},
+		{"bad time", "YWJjOmE", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rooms, next, err := listRooms(infos, RoomFilter{}, tt.cursor, 10)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("listRooms() error = %v, want error %v", err, tt.wantErr)
+			} else if err != nil {
+				return
+			}
+
+			if got := roomIDs(rooms); !reflect.DeepEqual(got, tt.wantIDs) {
+				t.Errorf("listRooms() = %v, want %v", got, tt.wantIDs)
+			}
+			if next != "" {
+				t.Errorf("next cursor = %q, want none", next)
+			}
+		})
+	}
+}
+
+func TestRoomFilter(t *testing.T) {
+	open, inProgress := proto.RoomState_ROOM_STATE_OPEN, proto.RoomState_ROOM_STATE_IN_PROGRESS
+	countdown := proto.RoomState_ROOM_STATE_COUNTDOWN
+
+	tests := []struct {
+		name   string
+		filter RoomFilter
+		want   []string
+	}{
+		{"none", RoomFilter{}, []string{"a", "b", "c", "e", "f"}},
+		{"not full", RoomFilter{NotFull: true}, []string{"a", "c", "e", "f"}},
+		{"open", RoomFilter{States: []proto.RoomState{open}}, []string{"a", "b", "e", "f"}},
+		{"several states", RoomFilter{States: []proto.RoomState{inProgress, countdown}}, []string{"c"}},
+		{"tag", RoomFilter{Tag: "ranked"}, []string{"c", "e"}},
+		{"every filter", RoomFilter{NotFull: true, States: []proto.RoomState{open}, Tag: "casual"}, []string{"a", "e"}},
+		{"no match", RoomFilter{States: []proto.RoomState{countdown}}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rooms, _, err := listRooms(testRoomInfos(), tt.filter, "", maxRoomsPage)
+			if err != nil {
+				t.Fatalf("listRooms() error = %v", err)
+			}
+			if got := roomIDs(rooms); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("listRooms() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoomPageLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int32
+		want  int
+	}{
+		{"default", 0, roomsPerPage},
+		{"negative", -5, roomsPerPage},
+		{"one", 1, 1},
+		{"max", maxRoomsPage, maxRoomsPage},
+		{"over max", maxRoomsPage + 1, maxRoomsPage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roomPageLimit(tt.limit); got != tt.want {
+				t.Errorf("roomPageLimit(%v) = %v, want %v", tt.limit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindOpenRoom(t *testing.T) {
+	tests := []struct {
+		name    string
+		seats   int
+		exclude map[string]bool
+		want    string
+	}{
+		{"oldest", 1, nil, "a"},
+		{"party fits", 3, nil, "a"},
+		{"party too big for every room", 4, nil, ""},
+		{"room excluded", 1, map[string]bool{"a": true}, "e"},
+		{"only small room left", 1, map[string]bool{"a": true, "e": true}, "f"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if info := findOpenRoom(testRoomInfos(), tt.seats, tt.exclude); info != nil {
+				got = info.Id
+			}
+			if got != tt.want {
+				t.Errorf("findOpenRoom() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,9 +9,100 @@ import (
 const (
 	port         = ":3000"            // Port for the GRPC server
 	migrationDir = "./db"             // Directory that holds the SQL files
+	filterDir    = "./filter"         // Directory that holds the chat filter word lists
 	roomSize     = 5                  // Max users in a room
 	tokenBytes   = 16                 // Num bytes in the auth token, num chars in the token will be tokenBytes * 2
 	tokenExpire  = time.Hour * 24 * 7 // Time till auth tokens expire
+
+	// Rooms
+	roomIDBytes     = 8               // Num bytes in a room ID
+	roomsPerPage    = 20              // Default num rooms in a room listing page
+	maxRoomsPage    = 100             // Max num rooms in a room listing page
+	maxRoomTags     = 5               // Max num tags on a room
+	roomEventBuffer = 64              // Num room events buffered per user before events are dropped
+	countdownTime   = time.Second * 5 // Time from everyone being ready till the game starts
+
+	// Spectators
+	maxSpectators             = 50                     // Max spectators in a room
+	maxSpectatorDelay         = time.Minute * 5        // Max delay the host can set for spectators
+	spectatorEventBuffer      = roomEventBuffer * 4    // Events buffered for a spectator before they are read into the delay queue
+	spectatorSnapshotInterval = time.Millisecond * 100 // Time between the game snapshots published for spectators
+	spectatorQueueSize        = 4096                   // Max events held back by a spectator's delay, covers the max delay of snapshots
+
+	// Instances
+	instanceTTL       = time.Second * 15 // Time till an instance that stopped sending heartbeats is considered dead
+	heartbeatInterval = time.Second * 5  // Time between instance heartbeats
+	publishBuffer     = 1024             // Room events queued for publishing before events are dropped
+	sweepInterval     = time.Minute      // Time between sweeps for rooms abandoned by stopped instances
+	restoreWindow     = time.Minute * 2  // Time a stopped instance has to restart and restore its rooms before they are removed
+
+	// Reconnects
+	reconnectGrace  = time.Second * 30 // Time a disconnected user keeps their slot in the room
+	roomHistorySize = 256              // Num recent room events kept for replay on reconnect
+
+	// Room chat
+	maxChatLength   = 200              // Max num characters in a chat message
+	chatHistorySize = 50               // Num recent chat messages kept per room
+	chatRateLimit   = 5                // Max num chat messages a user can send within the rate window
+	chatRateWindow  = time.Second * 10 // Window used to rate limit chat messages
+
+	// Snapshots
+	snapshotInterval = time.Second * 5  // Time between room snapshots
+	snapshotTTL      = time.Minute * 10 // Time till the snapshots of an instance that is not restarted expire
+
+	// Channel chat
+	chatStreamBuffer   = 64  // Messages buffered per chat stream before messages are dropped
+	maxChatHistoryPage = 100 // Max channel messages returned per history page
+
+	// Content filters
+	spamRepeatLimit = 2                // Times a message can be repeated within the spam window, the next repeat is rejected
+	spamWindow      = time.Second * 30 // Window of time that repeated messages are counted in
+	floodThreshold  = 8.0              // Flood score that gets a user muted
+	floodDecay      = 0.5              // Flood score removed per second
+	floodMuteTime   = time.Minute * 5  // Time users are muted for flooding
+
+	// Friends
+	maxFriends           = 200 // Max friends per user
+	presenceStreamBuffer = 64  // Presence updates buffered per stream before updates are dropped
+
+	// Parties
+	maxPartySize      = roomSize // Max members in a party, a party must fit in a room
+	partyStreamBuffer = 64       // Party events buffered per stream before events are dropped
+	partyTxRetries    = 10       // Times a party change is retried when the party is changed at the same time
+
+	// Guilds
+	maxGuildMembers = 100 // Max members in a guild
+
+	// Simulation
+	defaultTickRate   = 20     // Simulation ticks per second when TICK_RATE is not set
+	maxTickRate       = 128    // Max simulation ticks per second
+	playerSpeed       = 5.0    // Units per second a player moves
+	playerRadius      = 0.5    // Radius of a player's collider
+	spawnRadius       = 5.0    // Distance from the center of the world that players spawn at
+	spawnClearance    = 20.0   // Distance from other entities a spawn counts as open for a player joining a restored game
+	maxQueuedInputs   = 16     // Max inputs queued per player per tick, extra inputs are rejected
+	maxTargetDistance = 1000.0 // Max distance from the center of the world of a move target
+	gridCellSize      = 10.0   // Size of the spatial grid cells used for entity proximity queries
+	viewRadius        = 50.0   // Distance from a player's entity that other entities come into view
+	viewMargin        = 5.0    // Extra distance past the view radius before an entity leaves the view
+
+	// World map
+	mapFile          = "./maps/overworld.map" // Overworld map file, created if it does not exist
+	chunkSize        = 32                     // Width and height of a map chunk in tiles
+	tileSize         = 2.0                    // Width and height of a tile in world units
+	defaultMapChunks = 16                     // Width and height in chunks of the map created when there is no map file
+	maxMapChunks     = 256                    // Max width and height in chunks of a map file
+	maxChunkRadius   = 4                      // Max radius of chunks that can be requested at once
+
+	// Pathfinding
+	maxPathSearch  = 8192 // Max tiles searched for a path, past this the path goes to the closest tile found
+	pathSearchRate = 4    // Max path searches per player per second, only the latest point is searched
+	maxCachedPaths = 256  // Num recent paths cached per game
+
+	// Delta snapshots
+	positionScale   = 100             // Positions and velocities are sent in 1/positionScale units
+	maxBaselineAge  = 32              // Num ticks a state can be used as a delta baseline, older acks get full snapshots
+	bandwidthWindow = time.Second * 5 // Window used to measure snapshot bandwidth
 )
 
 func main() {
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/cdrpl/granny/server/proto"
+)
+
+// BandwidthMeter measures the bytes sent within a sliding window of time.
+type BandwidthMeter struct {
+	sends []sentBytes // Sends within the window, oldest first
+	total int         // Sum of the bytes in sends
+}
+
+// sentBytes is the number of bytes sent at a time.
+type sentBytes struct {
+	bytes int
+	time  time.Time
+}
+
+// Record bytes sent at the given time.
+func (m *BandwidthMeter) add(bytes int, now time.Time) {
+	m.forget(now)
+	m.sends = append(m.sends, sentBytes{bytes: bytes, time: now})
+	m.total += bytes
+}
+
+// Return the average bytes sent per second within the window.
+func (m *BandwidthMeter) rate(now time.Time) float64 {
+	m.forget(now)
+	return float64(m.total) / bandwidthWindow.Seconds()
+}
+
+// Forget sends that are outside of the window.
+func (m *BandwidthMeter) forget(now time.Time) {
+	for len(m.sends) > 0 && now.Sub(m.sends[0].time) > bandwidthWindow {
+		m.total -= m.sends[0].bytes
+		m.sends = m.sends[1:]
+	}
+}
+
+// snapshotClient tracks the snapshots a user has acknowledged, what the user can see and the bandwidth used to send them.
+type snapshotClient struct {
+	ackTick        int64                  // Tick of the last snapshot the user acknowledged
+	view           map[int]bool           // IDs of the entities in the last snapshot sent
+	views          map[int64]map[int]bool // Tick to the entities in view in the snapshot sent at that tick, kept for baselines
+	bytes          BandwidthMeter         // Snapshot bytes sent
+	fullBytes      BandwidthMeter         // Bytes that full snapshots would have used
+	fullSnapshots  int64
+	deltaSnapshots int64
+}
+
+// Return the snapshot client of the user, it is created if the user does not have one.
+func (sim *Simulation) client(userID int) *snapshotClient {
+	client, ok := sim.clients[userID]
+	if !ok {
+		client = &snapshotClient{views: make(map[int64]map[int]bool)}
+		sim.clients[userID] = client
+	}
+	return client
+}
+
+// Record a snapshot sent to the client, fullSize is the size the snapshot would have been without delta compression
+// or interest filtering.
+func (c *snapshotClient) sent(size, fullSize int, full bool, now time.Time) {
+	c.bytes.add(size, now)
+	c.fullBytes.add(fullSize, now)
+
+	if full {
+		c.fullSnapshots++
+	} else {
+		c.deltaSnapshots++
+	}
+}
+
+// Return the snapshot bandwidth of every user in the room, errNotInProgress is returned if there is no game.
+func (r *Room) netStats(id int) ([]*proto.ClientNetStats, error) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return nil, errNotInRoom
+	} else if r.sim == nil {
+		return nil, errNotInProgress
+	}
+
+	now := time.Now()
+	stats := make([]*proto.ClientNetStats, 0, len(r.users))
+
+	for _, user := range r.users {
+		client := r.sim.client(user.id)
+		stats = append(stats, &proto.ClientNetStats{
+			User:               user.proto(),
+			BytesPerSecond:     float32(client.bytes.rate(now)),
+			FullBytesPerSecond: float32(client.fullBytes.rate(now)),
+			FullSnapshots:      client.fullSnapshots,
+			DeltaSnapshots:     client.deltaSnapshots,
+		})
+	}
+
+	return stats, nil
+}
+
+// GetNetStats will return the snapshot bandwidth of every user in the user's room.
+func (s *Server) GetNetStats(ctx context.Context, in *proto.GetNetStatsReq) (*proto.GetNetStatsRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	room, client, err := s.routeUserRoom(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if client != nil {
+		return client.GetNetStats(s.forwardContext(ctx), in)
+	}
+
+	stats, err := room.netStats(id)
+	if err != nil {
+		return nil, roomError("get net stats error", err)
+	}
+
+	return &proto.GetNetStatsRes{Clients: stats}, nil
+}
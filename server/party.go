@@ -0,0 +1,570 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cdrpl/granny/server/proto"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Redis keys used by parties.
+const (
+	partyKeyPrefix    = "party:"        // party:<party id> holds the serialized PartyInfo
+	userPartiesKey    = "user-parties"  // Hash of user ID to the ID of the party the user is in
+	partyEventsPrefix = "party-events:" // party-events:<user id> is the pub/sub channel for the user's party events
+)
+
+var (
+	errPartyNotFound = errors.New("Party does not exist")
+	errNotInParty    = errors.New("User is not in the party")
+	errInParty       = errors.New("User is already in a party")
+	errNotLeader     = errors.New("Only the party leader can do that")
+	errNotInvited    = errors.New("User has not been invited")
+	errPartyFull     = errors.New("Party is full")
+)
+
+// Parties are stored in Redis so every instance can change them. Changes are made in optimistic transactions
+// and every change is published to the members and invitees of the party.
+type Parties struct {
+	rdb     *redis.Client
+	streams map[int]chan *proto.PartyEvent // User ID to the user's party event stream
+	mut     sync.Mutex
+}
+
+func newParties(rdb *redis.Client) *Parties {
+	return &Parties{rdb: rdb, streams: make(map[int]chan *proto.PartyEvent)}
+}
+
+// Receive party events from every instance and deliver them to the local streams.
+func (p *Parties) run() {
+	pubsub := p.rdb.PSubscribe(context.Background(), partyEventsPrefix+"*")
+
+	for msg := range pubsub.Channel() {
+		userID, _ := strconv.Atoi(strings.TrimPrefix(msg.Channel, partyEventsPrefix))
+
+		event := &proto.PartyEvent{}
+		if err := protobuf.Unmarshal([]byte(msg.Payload), event); err != nil {
+			log.Println("party event unmarshal error:", err)
+			continue
+		}
+
+		p.mut.Lock()
+		if events, ok := p.streams[userID]; ok {
+			select {
+			case events <- event:
+			default:
+			}
+		}
+		p.mut.Unlock()
+	}
+}
+
+// Open a party event stream for the user, an existing stream for the user is closed.
+func (p *Parties) openStream(userID int) chan *proto.PartyEvent {
+	events := make(chan *proto.PartyEvent, partyStreamBuffer)
+
+	p.mut.Lock()
+	defer p.mut.Unlock()
+
+	if old, ok := p.streams[userID]; ok {
+		close(old)
+	}
+	p.streams[userID] = events
+
+	return events
+}
+
+// Close the stream if it is still the user's stream.
+func (p *Parties) closeStream(userID int, events chan *proto.PartyEvent) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+
+	if p.streams[userID] == events {
+		delete(p.streams, userID)
+		close(events)
+	}
+}
+
+// Return the party the user is in or nil if the user is not in a party.
+func (p *Parties) userParty(userID int) (*proto.PartyInfo, error) {
+	id, err := p.rdb.HGet(context.Background(), userPartiesKey, strconv.Itoa(userID)).Result()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return p.get(id)
+}
+
+// Return the party or nil if it does not exist.
+func (p *Parties) get(id string) (*proto.PartyInfo, error) {
+	raw, err := p.rdb.Get(context.Background(), partyKeyPrefix+id).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	party := &proto.PartyInfo{}
+	return party, protobuf.Unmarshal(raw, party)
+}
+
+// Create a party led by the user.
+func (p *Parties) create(leader *proto.User) (*proto.PartyInfo, error) {
+	id, err := generateToken(roomIDBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := p.rdb.HSetNX(context.Background(), userPartiesKey, strconv.Itoa(int(leader.Id)), id).Result()
+	if err != nil {
+		return nil, err
+	} else if !claimed {
+		return nil, errInParty
+	}
+
+	party := &proto.PartyInfo{
+		Id:        id,
+		Leader:    leader,
+		Members:   []*proto.User{leader},
+		CreatedAt: time.Now().UnixNano() / int64(time.Millisecond),
+	}
+
+	bytes, err := protobuf.Marshal(party)
+	if err == nil {
+		err = p.rdb.Set(context.Background(), partyKeyPrefix+id, bytes, 0).Err()
+	}
+	if err != nil {
+		p.rdb.HDel(context.Background(), userPartiesKey, strconv.Itoa(int(leader.Id)))
+		return nil, err
+	}
+
+	return party, nil
+}
+
+// Change a party in a transaction. The change function modifies the party and returns the events to publish,
+// it may be called more than once if the party is changed by someone else at the same time.
+// Members that are no longer in the party are released and the party is deleted once it has no members.
+func (p *Parties) update(id string, change func(party *proto.PartyInfo) ([]*proto.PartyEvent, error)) (*proto.PartyInfo, error) {
+	ctx := context.Background()
+	key := partyKeyPrefix + id
+
+	var party *proto.PartyInfo
+	txf := func(tx *redis.Tx) error {
+		raw, err := tx.Get(ctx, key).Bytes()
+		if err == redis.Nil {
+			return errPartyNotFound
+		} else if err != nil {
+			return err
+		}
+
+		party = &proto.PartyInfo{}
+		if err := protobuf.Unmarshal(raw, party); err != nil {
+			return err
+		}
+		before := protobuf.Clone(party).(*proto.PartyInfo)
+
+		events, err := change(party)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			if len(party.Members) == 0 {
+				pipe.Del(ctx, key)
+			} else {
+				bytes, err := protobuf.Marshal(party)
+				if err != nil {
+					return err
+				}
+				pipe.Set(ctx, key, bytes, 0)
+			}
+
+			for _, member := range before.Members {
+				if !hasPartyUser(party.Members, int(member.Id)) {
+					pipe.HDel(ctx, userPartiesKey, strconv.Itoa(int(member.Id)))
+				}
+			}
+
+			// Everyone that was or is in the party or invited to it receives the events
+			recipients := make(map[int32]bool)
+			for _, users := range [][]*proto.User{before.Members, before.Invites, party.Members, party.Invites} {
+				for _, user := range users {
+					recipients[user.Id] = true
+				}
+			}
+
+			for _, event := range events {
+				if len(party.Members) > 0 {
+					event.Party = party
+				}
+
+				bytes, err := protobuf.Marshal(event)
+				if err != nil {
+					return err
+				}
+
+				for id := range recipients {
+					pipe.Publish(ctx, partyEventsPrefix+strconv.Itoa(int(id)), bytes)
+				}
+			}
+
+			return nil
+		})
+		return err
+	}
+
+	for i := 0; i < partyTxRetries; i++ {
+		err := p.rdb.Watch(ctx, txf, key)
+		if err != redis.TxFailedErr {
+			return party, err
+		}
+	}
+
+	return nil, errors.New("Party is busy, try again")
+}
+
+// Publish an event to every member of the party.
+func (p *Parties) publish(party *proto.PartyInfo, event *proto.PartyEvent) error {
+	event.Party = party
+
+	bytes, err := protobuf.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.rdb.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		for _, member := range party.Members {
+			pipe.Publish(context.Background(), partyEventsPrefix+strconv.Itoa(int(member.Id)), bytes)
+		}
+		return nil
+	})
+	return err
+}
+
+// Check if the user is in the list.
+func hasPartyUser(users []*proto.User, userID int) bool {
+	return partyUserIndex(users, userID) >= 0
+}
+
+// Return the index of the user in the list or -1 if the user is not in it.
+func partyUserIndex(users []*proto.User, userID int) int {
+	for i, user := range users {
+		if int(user.Id) == userID {
+			return i
+		}
+	}
+	return -1
+}
+
+// Remove the user from the list.
+func removePartyUser(users []*proto.User, userID int) []*proto.User {
+	if i := partyUserIndex(users, userID); i >= 0 {
+		return append(users[:i], users[i+1:]...)
+	}
+	return users
+}
+
+// Invite the user to the party. Pending invites count towards the party size so accepting them can not overfill it.
+func inviteToParty(party *proto.PartyInfo, leaderID int, user *proto.User) ([]*proto.PartyEvent, error) {
+	if int(party.Leader.Id) != leaderID {
+		return nil, errNotLeader
+	} else if hasPartyUser(party.Members, int(user.Id)) {
+		return nil, errors.New("User is already in the party")
+	} else if hasPartyUser(party.Invites, int(user.Id)) {
+		return nil, errors.New("User has already been invited")
+	} else if len(party.Members)+len(party.Invites) >= maxPartySize {
+		return nil, errPartyFull
+	}
+
+	party.Invites = append(party.Invites, user)
+	return []*proto.PartyEvent{{Event: &proto.PartyEvent_Invited{Invited: user}}}, nil
+}
+
+// Move the user from the invites to the members of the party.
+func acceptPartyInvite(party *proto.PartyInfo, userID int) ([]*proto.PartyEvent, error) {
+	i := partyUserIndex(party.Invites, userID)
+	if i < 0 {
+		return nil, errNotInvited
+	} else if len(party.Members) >= maxPartySize {
+		return nil, errPartyFull
+	}
+
+	user := party.Invites[i]
+	party.Invites = removePartyUser(party.Invites, userID)
+	party.Members = append(party.Members, user)
+	return []*proto.PartyEvent{{Event: &proto.PartyEvent_MemberJoined{MemberJoined: user}}}, nil
+}
+
+// Remove the user from the invites of the party.
+func declinePartyInvite(party *proto.PartyInfo, userID int) ([]*proto.PartyEvent, error) {
+	i := partyUserIndex(party.Invites, userID)
+	if i < 0 {
+		return nil, errNotInvited
+	}
+
+	user := party.Invites[i]
+	party.Invites = removePartyUser(party.Invites, userID)
+	return []*proto.PartyEvent{{Event: &proto.PartyEvent_InviteDeclined{InviteDeclined: user}}}, nil
+}
+
+// Remove the user from the members of the party, passing on leadership or disbanding the party when needed.
+func leaveParty(party *proto.PartyInfo, userID int) ([]*proto.PartyEvent, error) {
+	i := partyUserIndex(party.Members, userID)
+	if i < 0 {
+		return nil, errNotInParty
+	}
+
+	user := party.Members[i]
+	party.Members = removePartyUser(party.Members, userID)
+	events := []*proto.PartyEvent{{Event: &proto.PartyEvent_MemberLeft{MemberLeft: user}}}
+
+	if len(party.Members) == 0 {
+		party.Invites = nil
+		events = append(events, &proto.PartyEvent{Event: &proto.PartyEvent_Disbanded{Disbanded: true}})
+	} else if int(party.Leader.Id) == userID {
+		party.Leader = party.Members[0]
+		events = append(events, &proto.PartyEvent{Event: &proto.PartyEvent_LeaderChanged{LeaderChanged: party.Leader}})
+	}
+
+	return events, nil
+}
+
+// Make another member the leader of the party.
+func transferPartyLeader(party *proto.PartyInfo, leaderID, userID int) ([]*proto.PartyEvent, error) {
+	if int(party.Leader.Id) != leaderID {
+		return nil, errNotLeader
+	}
+
+	i := partyUserIndex(party.Members, userID)
+	if i < 0 {
+		return nil, errNotInParty
+	}
+
+	party.Leader = party.Members[i]
+	return []*proto.PartyEvent{{Event: &proto.PartyEvent_LeaderChanged{LeaderChanged: party.Leader}}}, nil
+}
+
+// CreateParty will create a party with the user as the leader.
+func (s *Server) CreateParty(ctx context.Context, in *proto.CreatePartyReq) (*proto.CreatePartyRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	user, err := findUser(id, s.pg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "create party error: %v", err)
+	}
+
+	party, err := s.parties.create(&proto.User{Id: int32(id), Name: user.Name})
+	if err != nil {
+		return nil, partyError("create party error", err)
+	}
+
+	return &proto.CreatePartyRes{Party: party}, nil
+}
+
+// GetParty will return the party the user is in.
+func (s *Server) GetParty(ctx context.Context, in *proto.GetPartyReq) (*proto.GetPartyRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	party, err := s.parties.userParty(id)
+	if err != nil {
+		log.Println("get party error:", err)
+		return nil, status.Error(codes.Internal, "get party error")
+	}
+
+	return &proto.GetPartyRes{Party: party}, nil
+}
+
+// InviteToParty will invite a friend to the user's party, only the leader can invite.
+func (s *Server) InviteToParty(ctx context.Context, in *proto.InviteToPartyReq) (*proto.InviteToPartyRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	inviteeID, err := s.resolveUser(in.GetUserId(), in.GetName())
+	if err != nil {
+		return nil, err
+	} else if inviteeID == id {
+		return nil, status.Error(codes.InvalidArgument, "can not invite yourself")
+	}
+
+	friends, err := areFriends(id, inviteeID, s.pg)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "invite to party error")
+	} else if !friends {
+		return nil, status.Error(codes.FailedPrecondition, "only friends can be invited")
+	}
+
+	if err := s.checkNotBlocked(id, inviteeID); err != nil {
+		return nil, err
+	}
+
+	invitee, err := findUser(inviteeID, s.pg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invite to party error: %v", err)
+	}
+
+	party, err := s.parties.userParty(id)
+	if err != nil {
+		log.Println("user party error:", err)
+		return nil, status.Error(codes.Internal, "invite to party error")
+	} else if party == nil {
+		return nil, status.Error(codes.FailedPrecondition, "user is not in a party")
+	}
+
+	user := &proto.User{Id: int32(inviteeID), Name: invitee.Name}
+	_, err = s.parties.update(party.Id, func(party *proto.PartyInfo) ([]*proto.PartyEvent, error) {
+		return inviteToParty(party, id, user)
+	})
+	if err != nil {
+		return nil, partyError("invite to party error", err)
+	}
+
+	return &proto.InviteToPartyRes{}, nil
+}
+
+// AcceptPartyInvite will add the user to a party they were invited to.
+func (s *Server) AcceptPartyInvite(ctx context.Context, in *proto.AcceptPartyInviteReq) (*proto.AcceptPartyInviteRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	// Claim the user first so they can not accept two invites at once
+	claimed, err := s.rdb.HSetNX(ctx, userPartiesKey, strconv.Itoa(id), in.PartyId).Result()
+	if err != nil {
+		log.Println("claim party user error:", err)
+		return nil, status.Error(codes.Internal, "accept party invite error")
+	} else if !claimed {
+		return nil, partyError("accept party invite error", errInParty)
+	}
+
+	party, err := s.parties.update(in.PartyId, func(party *proto.PartyInfo) ([]*proto.PartyEvent, error) {
+		return acceptPartyInvite(party, id)
+	})
+	if err != nil {
+		s.rdb.HDel(ctx, userPartiesKey, strconv.Itoa(id))
+		return nil, partyError("accept party invite error", err)
+	}
+
+	return &proto.AcceptPartyInviteRes{Party: party}, nil
+}
+
+// DeclinePartyInvite will decline an invite to a party.
+func (s *Server) DeclinePartyInvite(ctx context.Context, in *proto.DeclinePartyInviteReq) (*proto.DeclinePartyInviteRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	_, err := s.parties.update(in.PartyId, func(party *proto.PartyInfo) ([]*proto.PartyEvent, error) {
+		return declinePartyInvite(party, id)
+	})
+	if err != nil {
+		return nil, partyError("decline party invite error", err)
+	}
+
+	return &proto.DeclinePartyInviteRes{}, nil
+}
+
+// LeaveParty will remove the user from their party. Leadership passes to the member that joined first after the leader
+// and the party is disbanded once the last member leaves.
+func (s *Server) LeaveParty(ctx context.Context, in *proto.LeavePartyReq) (*proto.LeavePartyRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	party, err := s.parties.userParty(id)
+	if err != nil {
+		log.Println("user party error:", err)
+		return nil, status.Error(codes.Internal, "leave party error")
+	} else if party == nil {
+		return nil, status.Error(codes.FailedPrecondition, "user is not in a party")
+	}
+
+	_, err = s.parties.update(party.Id, func(party *proto.PartyInfo) ([]*proto.PartyEvent, error) {
+		return leaveParty(party, id)
+	})
+	if err != nil {
+		return nil, partyError("leave party error", err)
+	}
+
+	return &proto.LeavePartyRes{}, nil
+}
+
+// TransferPartyLeader will make another member the leader of the party, only the leader can transfer leadership.
+func (s *Server) TransferPartyLeader(ctx context.Context, in *proto.TransferPartyLeaderReq) (*proto.TransferPartyLeaderRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	party, err := s.parties.userParty(id)
+	if err != nil {
+		log.Println("user party error:", err)
+		return nil, status.Error(codes.Internal, "transfer party leader error")
+	} else if party == nil {
+		return nil, status.Error(codes.FailedPrecondition, "user is not in a party")
+	}
+
+	_, err = s.parties.update(party.Id, func(party *proto.PartyInfo) ([]*proto.PartyEvent, error) {
+		return transferPartyLeader(party, id, int(in.UserId))
+	})
+	if err != nil {
+		return nil, partyError("transfer party leader error", err)
+	}
+
+	return &proto.TransferPartyLeaderRes{}, nil
+}
+
+// PartyEvents streams the events of the user's party and the invites the user receives.
+func (s *Server) PartyEvents(req *proto.PartyEventsReq, stream proto.Party_PartyEventsServer) error {
+	id, _, _ := extractUserIDAndToken(stream.Context())
+
+	events := s.parties.openStream(id)
+	defer s.parties.closeStream(id, events)
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return nil // Another stream was opened
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// Return the users that join a room with the user. Party members join with their leader,
+// members other than the leader can not join rooms while they are in a party.
+func (s *Server) roomGroup(userID int, name string) ([]*proto.User, *proto.PartyInfo, error) {
+	self := []*proto.User{{Id: int32(userID), Name: name}}
+
+	party, err := s.parties.userParty(userID)
+	if err != nil {
+		log.Println("user party error:", err)
+		return nil, nil, status.Error(codes.Internal, "join room error")
+	} else if party == nil || len(party.Members) == 1 {
+		return self, nil, nil
+	} else if int(party.Leader.Id) != userID {
+		return nil, nil, status.Error(codes.FailedPrecondition, "only the party leader can join rooms")
+	}
+
+	return party.Members, party, nil
+}
+
+// Convert a party error to a gRPC status error.
+func partyError(msg string, err error) error {
+	switch err {
+	case errPartyNotFound:
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errNotLeader:
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case errInParty:
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+}
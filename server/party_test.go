@@ -0,0 +1,250 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cdrpl/granny/server/proto"
+)
+
+// Return a party led by the first member with users named after their IDs.
+func testParty(members []int, invites []int) *proto.PartyInfo {
+	users := func(ids []int) []*proto.User {
+		list := make([]*proto.User, len(ids))
+		for i, id := range ids {
+			list[i] = &proto.User{Id: int32(id), Name: fmt.Sprint("user", id)}
+		}
+		return list
+	}
+
+	party := &proto.PartyInfo{Id: "party", Members: users(members), Invites: users(invites)}
+	if len(party.Members) > 0 {
+		party.Leader = party.Members[0]
+	}
+	return party
+}
+
+// Return the IDs of the users in the list.
+func partyUserIDs(users []*proto.User) []int {
+	ids := make([]int, len(users))
+	for i, user := range users {
+		ids[i] = int(user.Id)
+	}
+	return ids
+}
+
+// Return the IDs of a party with the size given in members and pending invites.
+func partyIDs(members, invites int) ([]int, []int) {
+	var memberIDs, inviteIDs []int
+	for i := 0; i < members; i++ {
+		memberIDs = append(memberIDs, i+1)
+	}
+	for i := 0; i < invites; i++ {
+		inviteIDs = append(inviteIDs, 100+i)
+	}
+	return memberIDs, inviteIDs
+}
+
+func TestInviteToParty(t *testing.T) {
+	tests := []struct {
+		name             string
+		members, invites int
+		leaderID         int
+		inviteeID        int
+		wantErr          bool
+		errIs            error
+	}{
+		{"invite", 2, 0, 1, 50, false, nil},
+		{"not leader", 2, 0, 2, 50, true, errNotLeader},
+		{"already a member", 2, 0, 1, 2, true, nil},
+		{"already invited", 2, 1, 1, 100, true, nil},
+		{"last seat", maxPartySize - 1, 0, 1, 50, false, nil},
+		{"members full", maxPartySize, 0, 1, 50, true, errPartyFull},
+		{"invites fill the party", 1, maxPartySize - 1, 1, 50, true, errPartyFull},
+		{"members and invites fill the party", maxPartySize - 2, 2, 1, 50, true, errPartyFull},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			party := testParty(partyIDs(tt.members, tt.invites))
+			invitee := &proto.User{Id: int32(tt.inviteeID), Name: "invitee"}
+
+			events, err := inviteToParty(party, tt.leaderID, invitee)
+			if (err != nil) != tt.wantErr || (tt.errIs != nil && err != tt.errIs) {
+				t.Fatalf("inviteToParty() error = %v, want error %v %v", err, tt.wantErr, tt.errIs)
+			}
+			if err != nil {
+				if len(party.Invites) != tt.invites {
+					t.Errorf("invites changed on error, got %v", partyUserIDs(party.Invites))
+				}
+				return
+			}
+
+			if !hasPartyUser(party.Invites, tt.inviteeID) {
+				t.Errorf("invites = %v, want them to include %v", partyUserIDs(party.Invites), tt.inviteeID)
+			}
+			if len(events) != 1 || events[0].GetInvited() != invitee {
+				t.Errorf("events = %v, want one invited event", events)
+			}
+		})
+	}
+}
+
+// Every pending invite can be accepted, so a party filled with invites never goes past the max size.
+func TestPartyInvitesNeverOverfill(t *testing.T) {
+	party := testParty([]int{1}, nil)
+	for id := 2; ; id++ {
+		if _, err := inviteToParty(party, 1, &proto.User{Id: int32(id)}); err == errPartyFull {
+			break
+		} else if err != nil {
+			t.Fatalf("inviteToParty() error = %v", err)
+		}
+	}
+
+	for _, invitee := range partyUserIDs(party.Invites) {
+		if _, err := acceptPartyInvite(party, invitee); err != nil {
+			t.Errorf("acceptPartyInvite(%v) error = %v", invitee, err)
+		}
+	}
+	if len(party.Members) != maxPartySize {
+		t.Errorf("party has %v members, want %v", len(party.Members), maxPartySize)
+	}
+}
+
+func TestAcceptPartyInvite(t *testing.T) {
+	full, _ := partyIDs(maxPartySize, 0)
+
+	tests := []struct {
+		name    string
+		members []int
+		invites []int
+		userID  int
+		err     error
+	}{
+		{"accept", []int{1}, []int{2, 3}, 3, nil},
+		{"not invited", []int{1}, []int{2}, 3, errNotInvited},
+		{"already a member", []int{1, 2}, nil, 2, errNotInvited},
+		// Parties saved before invites counted towards the size can hold more invites than seats
+		{"party full", full, []int{100}, 100, errPartyFull},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			party := testParty(tt.members, tt.invites)
+
+			events, err := acceptPartyInvite(party, tt.userID)
+			if err != tt.err {
+				t.Fatalf("acceptPartyInvite() error = %v, want %v", err, tt.err)
+			} else if err != nil {
+				return
+			}
+
+			if hasPartyUser(party.Invites, tt.userID) || !hasPartyUser(party.Members, tt.userID) {
+				t.Errorf("members, invites = %v, %v, want %v moved to the members",
+					partyUserIDs(party.Members), partyUserIDs(party.Invites), tt.userID)
+			}
+			if len(events) != 1 || int(events[0].GetMemberJoined().GetId()) != tt.userID {
+				t.Errorf("events = %v, want one member joined event", events)
+			}
+		})
+	}
+}
+
+func TestDeclinePartyInvite(t *testing.T) {
+	party := testParty([]int{1}, []int{2, 3})
+
+	if _, err := declinePartyInvite(party, 2); err != nil {
+		t.Fatalf("declinePartyInvite() error = %v", err)
+	}
+	if got := partyUserIDs(party.Invites); len(got) != 1 || got[0] != 3 {
+		t.Errorf("invites = %v, want [3]", got)
+	}
+	if _, err := declinePartyInvite(party, 2); err != errNotInvited {
+		t.Errorf("declined twice, error = %v, want %v", err, errNotInvited)
+	}
+}
+
+func TestLeaveParty(t *testing.T) {
+	tests := []struct {
+		name          string
+		members       []int
+		invites       []int
+		userID        int
+		err           error
+		wantLeader    int
+		wantDisbanded bool
+	}{
+		{"member leaves", []int{1, 2, 3}, nil, 3, nil, 1, false},
+		{"leader leaves", []int{1, 2, 3}, nil, 1, nil, 2, false},
+		{"last member leaves", []int{1}, []int{2}, 1, nil, 0, true},
+		{"not a member", []int{1, 2}, []int{3}, 3, errNotInParty, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			party := testParty(tt.members, tt.invites)
+
+			events, err := leaveParty(party, tt.userID)
+			if err != tt.err {
+				t.Fatalf("leaveParty() error = %v, want %v", err, tt.err)
+			} else if err != nil {
+				return
+			}
+
+			if hasPartyUser(party.Members, tt.userID) {
+				t.Errorf("members = %v, want %v removed", partyUserIDs(party.Members), tt.userID)
+			}
+			if int(events[0].GetMemberLeft().GetId()) != tt.userID {
+				t.Errorf("first event = %v, want member left", events[0])
+			}
+
+			disbanded := false
+			for _, event := range events {
+				disbanded = disbanded || event.GetDisbanded()
+			}
+			if disbanded != tt.wantDisbanded {
+				t.Errorf("disbanded = %v, want %v", disbanded, tt.wantDisbanded)
+			}
+			if tt.wantDisbanded {
+				if len(party.Invites) != 0 {
+					t.Errorf("disbanded party kept invites %v", partyUserIDs(party.Invites))
+				}
+			} else if int(party.Leader.Id) != tt.wantLeader {
+				t.Errorf("leader = %v, want %v", party.Leader.Id, tt.wantLeader)
+			}
+		})
+	}
+}
+
+func TestTransferPartyLeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		leaderID int
+		userID   int
+		err      error
+	}{
+		{"transfer", 1, 2, nil},
+		{"not leader", 2, 3, errNotLeader},
+		{"not a member", 1, 4, errNotInParty},
+		{"invitee", 1, 5, errNotInParty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			party := testParty([]int{1, 2, 3}, []int{5})
+
+			_, err := transferPartyLeader(party, tt.leaderID, tt.userID)
+			if err != tt.err {
+				t.Fatalf("transferPartyLeader() error = %v, want %v", err, tt.err)
+			}
+
+			want := tt.userID
+			if err != nil {
+				want = 1
+			}
+			if int(party.Leader.Id) != want {
+				t.Errorf("leader = %v, want %v", party.Leader.Id, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"container/heap"
+	"container/list"
+	"math"
+)
+
+// Cost of moving through each terrain type compared to dirt. Terrain that is not walkable has no cost.
+var terrainCosts = [terrainCount]float64{
+	TerrainDirt:    1,
+	TerrainHill:    2,
+	TerrainCrystal: 1,
+}
+
+// Return the cost of moving through the tile, false is returned if the tile can not be walked through.
+func (m *TileMap) moveCost(x, y int) (float64, bool) {
+	terrain, ok := m.tile(x, y)
+	if !ok || !terrain.walkable() {
+		return 0, false
+	}
+	return terrainCosts[terrain], true
+}
+
+// Neighbours of a tile, straight steps first.
+var pathSteps = []GridCoord{
+	{X: 1, Y: 0}, {X: -1, Y: 0}, {X: 0, Y: 1}, {X: 0, Y: -1},
+	{X: 1, Y: 1}, {X: -1, Y: 1}, {X: 1, Y: -1}, {X: -1, Y: -1},
+}
+
+// pathNode is a tile reached by a path search.
+type pathNode struct {
+	tile   GridCoord
+	from   *pathNode // Previous tile on the cheapest known path from the start
+	cost   float64   // Cost of the cheapest known path from the start
+	score  float64   // Cost plus the estimated cost to the goal
+	dist   float64   // Estimated cost to the goal, used to break ties
+	index  int       // Index in the open queue
+	closed bool      // Set once the cheapest path to the tile is known
+}
+
+// pathQueue is a priority queue of path nodes, the node with the lowest score is popped first.
+type pathQueue []*pathNode
+
+func (q pathQueue) Len() int { return len(q) }
+
+func (q pathQueue) Less(i, j int) bool {
+	a, b := q[i], q[j]
+	if a.score != b.score {
+		return a.score < b.score
+	} else if a.dist != b.dist {
+		return a.dist < b.dist
+	} else if a.tile.Y != b.tile.Y {
+		return a.tile.Y < b.tile.Y
+	}
+	return a.tile.X < b.tile.X
+}
+
+func (q pathQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *pathQueue) Push(x interface{}) {
+	node := x.(*pathNode)
+	node.index = len(*q)
+	*q = append(*q, node)
+}
+
+func (q *pathQueue) Pop() interface{} {
+	old := *q
+	node := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return node
+}
+
+// Estimate the cost between the tiles, the cost of the shortest path if every tile was dirt.
+func pathHeuristic(a, b GridCoord) float64 {
+	dx, dy := float64(abs(a.X-b.X)), float64(abs(a.Y-b.Y))
+	return dx + dy + (math.Sqrt2-2)*math.Min(dx, dy)
+}
+
+// Find the cheapest path between the tiles with A*. Entities can move straight or diagonally, diagonal steps
+// can not cut the corner of a tile that is not walkable. The returned path starts with the tile after start and
+// ends with goal. If the goal can not be reached, or the search gives up after maxPathSearch tiles, the path goes
+// to the searched tile closest to the goal and false is returned.
+func (m *TileMap) findPath(start, goal GridCoord) ([]GridCoord, bool) {
+	first := &pathNode{tile: start, dist: pathHeuristic(start, goal)}
+	first.score = first.dist
+
+	nodes := map[GridCoord]*pathNode{start: first}
+	open := &pathQueue{first}
+	closest := first
+
+	for searched := 0; open.Len() > 0 && searched < maxPathSearch; searched++ {
+		node := heap.Pop(open).(*pathNode)
+		node.closed = true
+
+		if node.tile == goal {
+			return buildPath(node), true
+		} else if node.dist < closest.dist {
+			closest = node
+		}
+
+		for _, step := range pathSteps {
+			tile := gridAdd(node.tile, step)
+			next, seen := nodes[tile]
+			if seen && next.closed {
+				continue
+			}
+
+			cost, ok := m.moveCost(tile.X, tile.Y)
+			if !ok {
+				continue
+			}
+
+			length := 1.0
+			if step.X != 0 && step.Y != 0 {
+				// No cutting corners, both tiles beside the diagonal must be walkable
+				if _, ok := m.moveCost(node.tile.X+step.X, node.tile.Y); !ok {
+					continue
+				}
+				if _, ok := m.moveCost(node.tile.X, node.tile.Y+step.Y); !ok {
+					continue
+				}
+				length = math.Sqrt2
+			}
+
+			nextCost := node.cost + length*cost
+			if !seen {
+				next = &pathNode{tile: tile, from: node, cost: nextCost, dist: pathHeuristic(tile, goal)}
+				next.score = nextCost + next.dist
+				nodes[tile] = next
+				heap.Push(open, next)
+			} else if nextCost < next.cost {
+				next.from = node
+				next.cost = nextCost
+				next.score = nextCost + next.dist
+				heap.Fix(open, next.index)
+			}
+		}
+	}
+
+	return buildPath(closest), false
+}
+
+// Follow the path back from the end to the start, the start is not included.
+func buildPath(end *pathNode) []GridCoord {
+	var path []GridCoord
+	for node := end; node.from != nil; node = node.from {
+		path = append(path, node.tile)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// Return the world positions an entity at the start has to move through to reach the destination, ending with
+// the destination itself. Tiles in a straight line are merged so only the turns are waypoints. If the destination
+// can not be reached the waypoints go to the reachable tile closest to it and false is returned.
+func (m *TileMap) pathWaypoints(start, dest Vector, cache *PathCache) ([]Vector, bool) {
+	startX, startY := m.tileAt(start)
+	goalX, goalY := m.tileAt(dest)
+	tiles, complete := cache.findPath(m, GridCoord{X: startX, Y: startY}, GridCoord{X: goalX, Y: goalY})
+
+	var waypoints []Vector
+	for i, tile := range tiles {
+		// Skip tiles that continue in the same direction as the step after them
+		if i+1 < len(tiles) {
+			prev := GridCoord{X: startX, Y: startY}
+			if i > 0 {
+				prev = tiles[i-1]
+			}
+			next := tiles[i+1]
+			if tile.X-prev.X == next.X-tile.X && tile.Y-prev.Y == next.Y-tile.Y {
+				continue
+			}
+		}
+		waypoints = append(waypoints, m.tileCenter(tile.X, tile.Y))
+	}
+
+	if complete {
+		// The destination is somewhere in the goal tile, end on the destination instead of the tile center
+		if len(waypoints) > 0 {
+			waypoints = waypoints[:len(waypoints)-1]
+		}
+		waypoints = append(waypoints, dest)
+	}
+
+	return waypoints, complete
+}
+
+// pathKey is the start and goal of a path search.
+type pathKey struct {
+	start, goal GridCoord
+}
+
+// cachedPath is the result of a path search.
+type cachedPath struct {
+	key      pathKey
+	tiles    []GridCoord
+	complete bool
+}
+
+// PathCache holds the results of recent path searches so repeated searches between the same tiles are not
+// searched again, the least recently used path is dropped once the cache is full. Cached paths are never
+// changed by their users so they can be shared.
+type PathCache struct {
+	size    int
+	paths   map[pathKey]*list.Element
+	recency *list.List // Most recently used path at the front
+}
+
+func newPathCache(size int) *PathCache {
+	return &PathCache{size: size, paths: make(map[pathKey]*list.Element), recency: list.New()}
+}
+
+// Return the path between the tiles from the cache, the path is searched and cached if it is not in the cache.
+// A nil cache always searches.
+func (c *PathCache) findPath(m *TileMap, start, goal GridCoord) ([]GridCoord, bool) {
+	if c == nil {
+		return m.findPath(start, goal)
+	}
+
+	key := pathKey{start: start, goal: goal}
+	if elem, ok := c.paths[key]; ok {
+		c.recency.MoveToFront(elem)
+		path := elem.Value.(*cachedPath)
+		return path.tiles, path.complete
+	}
+
+	tiles, complete := m.findPath(start, goal)
+	c.paths[key] = c.recency.PushFront(&cachedPath{key: key, tiles: tiles, complete: complete})
+
+	if c.recency.Len() > c.size {
+		oldest := c.recency.Back()
+		c.recency.Remove(oldest)
+		delete(c.paths, oldest.Value.(*cachedPath).key)
+	}
+
+	return tiles, complete
+}
@@ -25,6 +25,157 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type RoomState int32
+
+const (
+	RoomState_ROOM_STATE_OPEN        RoomState = 0
+	RoomState_ROOM_STATE_IN_PROGRESS RoomState = 1
+	RoomState_ROOM_STATE_COUNTDOWN   RoomState = 2
+)
+
+// Enum value maps for RoomState.
+var (
+	RoomState_name = map[int32]string{
+		0: "ROOM_STATE_OPEN",
+		1: "ROOM_STATE_IN_PROGRESS",
+		2: "ROOM_STATE_COUNTDOWN",
+	}
+	RoomState_value = map[string]int32{
+		"ROOM_STATE_OPEN":        0,
+		"ROOM_STATE_IN_PROGRESS": 1,
+		"ROOM_STATE_COUNTDOWN":   2,
+	}
+)
+
+func (x RoomState) Enum() *RoomState {
+	p := new(RoomState)
+	*p = x
+	return p
+}
+
+func (x RoomState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_granny_proto_enumTypes[0].Descriptor()
+}
+
+func (RoomState) Type() protoreflect.EnumType {
+	return &file_proto_granny_proto_enumTypes[0]
+}
+
+func (x RoomState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomState.Descriptor instead.
+func (RoomState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{0}
+}
+
+type GuildRank int32
+
+const (
+	GuildRank_GUILD_RANK_MEMBER  GuildRank = 0
+	GuildRank_GUILD_RANK_OFFICER GuildRank = 1
+	GuildRank_GUILD_RANK_LEADER  GuildRank = 2
+)
+
+// Enum value maps for GuildRank.
+var (
+	GuildRank_name = map[int32]string{
+		0: "GUILD_RANK_MEMBER",
+		1: "GUILD_RANK_OFFICER",
+		2: "GUILD_RANK_LEADER",
+	}
+	GuildRank_value = map[string]int32{
+		"GUILD_RANK_MEMBER":  0,
+		"GUILD_RANK_OFFICER": 1,
+		"GUILD_RANK_LEADER":  2,
+	}
+)
+
+func (x GuildRank) Enum() *GuildRank {
+	p := new(GuildRank)
+	*p = x
+	return p
+}
+
+func (x GuildRank) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GuildRank) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_granny_proto_enumTypes[1].Descriptor()
+}
+
+func (GuildRank) Type() protoreflect.EnumType {
+	return &file_proto_granny_proto_enumTypes[1]
+}
+
+func (x GuildRank) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GuildRank.Descriptor instead.
+func (GuildRank) EnumDescriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{1}
+}
+
+// Values of the tiles in a map chunk.
+type Terrain int32
+
+const (
+	Terrain_TERRAIN_DIRT    Terrain = 0
+	Terrain_TERRAIN_ROCK    Terrain = 1
+	Terrain_TERRAIN_HILL    Terrain = 2
+	Terrain_TERRAIN_CRYSTAL Terrain = 3
+)
+
+// Enum value maps for Terrain.
+var (
+	Terrain_name = map[int32]string{
+		0: "TERRAIN_DIRT",
+		1: "TERRAIN_ROCK",
+		2: "TERRAIN_HILL",
+		3: "TERRAIN_CRYSTAL",
+	}
+	Terrain_value = map[string]int32{
+		"TERRAIN_DIRT":    0,
+		"TERRAIN_ROCK":    1,
+		"TERRAIN_HILL":    2,
+		"TERRAIN_CRYSTAL": 3,
+	}
+)
+
+func (x Terrain) Enum() *Terrain {
+	p := new(Terrain)
+	*p = x
+	return p
+}
+
+func (x Terrain) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Terrain) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_granny_proto_enumTypes[2].Descriptor()
+}
+
+func (Terrain) Type() protoreflect.EnumType {
+	return &file_proto_granny_proto_enumTypes[2]
+}
+
+func (x Terrain) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Terrain.Descriptor instead.
+func (Terrain) EnumDescriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{2}
+}
+
 type SignUpRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -186,9 +337,10 @@ type SignInResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Id             int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token          string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Name           string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UnreadMessages int32  `protobuf:"varint,4,opt,name=unread_messages,json=unreadMessages,proto3" json:"unread_messages,omitempty"` // Direct messages received while offline
 }
 
 func (x *SignInResponse) Reset() {
@@ -244,10 +396,19 @@ func (x *SignInResponse) GetName() string {
 	return ""
 }
 
+func (x *SignInResponse) GetUnreadMessages() int32 {
+	if x != nil {
+		return x.UnreadMessages
+	}
+	return 0
+}
+
 type GetRoomRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Defaults to the room the user is in
 }
 
 func (x *GetRoomRequest) Reset() {
@@ -282,12 +443,24 @@ func (*GetRoomRequest) Descriptor() ([]byte, []int) {
 	return file_proto_granny_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *GetRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 type GetRoomResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users map[int32]*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Users        map[int32]*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Room         *RoomInfo       `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	Ready        []int32         `protobuf:"varint,3,rep,packed,name=ready,proto3" json:"ready,omitempty"`               // IDs of the users that are ready
+	Seq          int64           `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`                          // Sequence number of the last room event
+	Disconnected []int32         `protobuf:"varint,5,rep,packed,name=disconnected,proto3" json:"disconnected,omitempty"` // IDs of the users that are reconnecting
+	ChatHistory  []*ChatMessage  `protobuf:"bytes,6,rep,name=chat_history,json=chatHistory,proto3" json:"chat_history,omitempty"`
 }
 
 func (x *GetRoomResponse) Reset() {
@@ -329,6 +502,41 @@ func (x *GetRoomResponse) GetUsers() map[int32]*User {
 	return nil
 }
 
+func (x *GetRoomResponse) GetRoom() *RoomInfo {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *GetRoomResponse) GetReady() []int32 {
+	if x != nil {
+		return x.Ready
+	}
+	return nil
+}
+
+func (x *GetRoomResponse) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GetRoomResponse) GetDisconnected() []int32 {
+	if x != nil {
+		return x.Disconnected
+	}
+	return nil
+}
+
+func (x *GetRoomResponse) GetChatHistory() []*ChatMessage {
+	if x != nil {
+		return x.ChatHistory
+	}
+	return nil
+}
+
 type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -435,6 +643,8 @@ type JoinRoomRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ChatHistory []*ChatMessage `protobuf:"bytes,1,rep,name=chat_history,json=chatHistory,proto3" json:"chat_history,omitempty"`
 }
 
 func (x *JoinRoomRes) Reset() {
@@ -469,6 +679,13 @@ func (*JoinRoomRes) Descriptor() ([]byte, []int) {
 	return file_proto_granny_proto_rawDescGZIP(), []int{8}
 }
 
+func (x *JoinRoomRes) GetChatHistory() []*ChatMessage {
+	if x != nil {
+		return x.ChatHistory
+	}
+	return nil
+}
+
 type UserJoinedReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -507,134 +724,9658 @@ func (*UserJoinedReq) Descriptor() ([]byte, []int) {
 	return file_proto_granny_proto_rawDescGZIP(), []int{9}
 }
 
-var File_proto_granny_proto protoreflect.FileDescriptor
+type RoomInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proto_granny_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x6e, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x0d, 0x53,
-	0x69, 0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x53, 0x69,
-	0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x0d,
-	0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x22, 0x4a, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x49,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x1a, 0x45, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2a, 0x0a, 0x04, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1d, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f,
-	0x6d, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d,
-	0x52, 0x65, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65,
-	0x64, 0x52, 0x65, 0x71, 0x32, 0x78, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x37, 0x0a, 0x06,
-	0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
-	0x69, 0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x12,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xad,
-	0x01, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f,
-	0x6f, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f,
-	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x12,
-	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d,
-	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
-	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0a, 0x55, 0x73, 0x65,
-	0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x30, 0x01, 0x42, 0x26,
-	0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x64, 0x72,
-	0x70, 0x6c, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x6e, 0x79, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Id             string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Users          int32     `protobuf:"varint,3,opt,name=users,proto3" json:"users,omitempty"`
+	Capacity       int32     `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	State          RoomState `protobuf:"varint,5,opt,name=state,proto3,enum=proto.RoomState" json:"state,omitempty"`
+	CreatedAt      int64     `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix time in milliseconds
+	Host           *User     `protobuf:"bytes,7,opt,name=host,proto3" json:"host,omitempty"`
+	Public         bool      `protobuf:"varint,8,opt,name=public,proto3" json:"public,omitempty"`
+	Tags           []string  `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	ReadyQuorum    int32     `protobuf:"varint,10,opt,name=ready_quorum,json=readyQuorum,proto3" json:"ready_quorum,omitempty"` // Num ready users needed to start the countdown, zero means every user
+	Spectators     int32     `protobuf:"varint,11,opt,name=spectators,proto3" json:"spectators,omitempty"`
+	SpectatorDelay int32     `protobuf:"varint,12,opt,name=spectator_delay,json=spectatorDelay,proto3" json:"spectator_delay,omitempty"` // Seconds spectators are behind the players
 }
 
-var (
-	file_proto_granny_proto_rawDescOnce sync.Once
-	file_proto_granny_proto_rawDescData = file_proto_granny_proto_rawDesc
-)
+func (x *RoomInfo) Reset() {
+	*x = RoomInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_granny_proto_rawDescGZIP() []byte {
-	file_proto_granny_proto_rawDescOnce.Do(func() {
-		file_proto_granny_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_granny_proto_rawDescData)
-	})
-	return file_proto_granny_proto_rawDescData
+func (x *RoomInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_granny_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_proto_granny_proto_goTypes = []interface{}{
-	(*SignUpRequest)(nil),   // 0: proto.SignUpRequest
-	(*SignUpResponse)(nil),  // 1: proto.SignUpResponse
-	(*SignInRequest)(nil),   // 2: proto.SignInRequest
-	(*SignInResponse)(nil),  // 3: proto.SignInResponse
-	(*GetRoomRequest)(nil),  // 4: proto.GetRoomRequest
-	(*GetRoomResponse)(nil), // 5: proto.GetRoomResponse
-	(*User)(nil),            // 6: proto.User
-	(*JoinRoomReq)(nil),     // 7: proto.JoinRoomReq
-	(*JoinRoomRes)(nil),     // 8: proto.JoinRoomRes
-	(*UserJoinedReq)(nil),   // 9: proto.UserJoinedReq
-	nil,                     // 10: proto.GetRoomResponse.UsersEntry
+func (*RoomInfo) ProtoMessage() {}
+
+func (x *RoomInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_granny_proto_depIdxs = []int32{
-	10, // 0: proto.GetRoomResponse.users:type_name -> proto.GetRoomResponse.UsersEntry
-	6,  // 1: proto.GetRoomResponse.UsersEntry.value:type_name -> proto.User
-	0,  // 2: proto.Auth.SignUp:input_type -> proto.SignUpRequest
-	2,  // 3: proto.Auth.SignIn:input_type -> proto.SignInRequest
-	4,  // 4: proto.Room.GetRoom:input_type -> proto.GetRoomRequest
-	7,  // 5: proto.Room.JoinRoom:input_type -> proto.JoinRoomReq
-	9,  // 6: proto.Room.UserJoined:input_type -> proto.UserJoinedReq
-	1,  // 7: proto.Auth.SignUp:output_type -> proto.SignUpResponse
-	3,  // 8: proto.Auth.SignIn:output_type -> proto.SignInResponse
-	5,  // 9: proto.Room.GetRoom:output_type -> proto.GetRoomResponse
-	8,  // 10: proto.Room.JoinRoom:output_type -> proto.JoinRoomRes
-	6,  // 11: proto.Room.UserJoined:output_type -> proto.User
-	7,  // [7:12] is the sub-list for method output_type
-	2,  // [2:7] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+
+// Deprecated: Use RoomInfo.ProtoReflect.Descriptor instead.
+func (*RoomInfo) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{10}
 }
 
-func init() { file_proto_granny_proto_init() }
-func file_proto_granny_proto_init() {
-	if File_proto_granny_proto != nil {
-		return
+func (x *RoomInfo) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_granny_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignUpRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *RoomInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoomInfo) GetUsers() int32 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetState() RoomState {
+	if x != nil {
+		return x.State
+	}
+	return RoomState_ROOM_STATE_OPEN
+}
+
+func (x *RoomInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetHost() *User {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+func (x *RoomInfo) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *RoomInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *RoomInfo) GetReadyQuorum() int32 {
+	if x != nil {
+		return x.ReadyQuorum
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetSpectators() int32 {
+	if x != nil {
+		return x.Spectators
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetSpectatorDelay() int32 {
+	if x != nil {
+		return x.SpectatorDelay
+	}
+	return 0
+}
+
+type CreateRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity       int32    `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"` // Defaults to the max room size
+	Public         bool     `protobuf:"varint,3,opt,name=public,proto3" json:"public,omitempty"`
+	Tags           []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	ReadyQuorum    int32    `protobuf:"varint,5,opt,name=ready_quorum,json=readyQuorum,proto3" json:"ready_quorum,omitempty"`          // Zero means every user must be ready
+	SpectatorDelay int32    `protobuf:"varint,6,opt,name=spectator_delay,json=spectatorDelay,proto3" json:"spectator_delay,omitempty"` // Seconds
+}
+
+func (x *CreateRoomReq) Reset() {
+	*x = CreateRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomReq) ProtoMessage() {}
+
+func (x *CreateRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proto_granny_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignUpResponse); i {
-			case 0:
-				return &v.state
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomReq.ProtoReflect.Descriptor instead.
+func (*CreateRoomReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateRoomReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoomReq) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CreateRoomReq) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *CreateRoomReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateRoomReq) GetReadyQuorum() int32 {
+	if x != nil {
+		return x.ReadyQuorum
+	}
+	return 0
+}
+
+func (x *CreateRoomReq) GetSpectatorDelay() int32 {
+	if x != nil {
+		return x.SpectatorDelay
+	}
+	return 0
+}
+
+type CreateRoomRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Room *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+}
+
+func (x *CreateRoomRes) Reset() {
+	*x = CreateRoomRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoomRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRes) ProtoMessage() {}
+
+func (x *CreateRoomRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRes.ProtoReflect.Descriptor instead.
+func (*CreateRoomRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateRoomRes) GetRoom() *RoomInfo {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type ListRoomsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NotFull bool        `protobuf:"varint,1,opt,name=not_full,json=notFull,proto3" json:"not_full,omitempty"`
+	States  []RoomState `protobuf:"varint,2,rep,packed,name=states,proto3,enum=proto.RoomState" json:"states,omitempty"` // Empty matches every state
+	Tag     string      `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Cursor  string      `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit   int32       `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListRoomsReq) Reset() {
+	*x = ListRoomsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoomsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsReq) ProtoMessage() {}
+
+func (x *ListRoomsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsReq.ProtoReflect.Descriptor instead.
+func (*ListRoomsReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListRoomsReq) GetNotFull() bool {
+	if x != nil {
+		return x.NotFull
+	}
+	return false
+}
+
+func (x *ListRoomsReq) GetStates() []RoomState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListRoomsReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListRoomsReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRoomsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRoomsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rooms      []*RoomInfo `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	NextCursor string      `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty when there are no more rooms
+}
+
+func (x *ListRoomsRes) Reset() {
+	*x = ListRoomsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoomsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsRes) ProtoMessage() {}
+
+func (x *ListRoomsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsRes.ProtoReflect.Descriptor instead.
+func (*ListRoomsRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListRoomsRes) GetRooms() []*RoomInfo {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *ListRoomsRes) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type LeaveRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveRoomReq) Reset() {
+	*x = LeaveRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveRoomReq) ProtoMessage() {}
+
+func (x *LeaveRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveRoomReq.ProtoReflect.Descriptor instead.
+func (*LeaveRoomReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{15}
+}
+
+type LeaveRoomRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveRoomRes) Reset() {
+	*x = LeaveRoomRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveRoomRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveRoomRes) ProtoMessage() {}
+
+func (x *LeaveRoomRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveRoomRes.ProtoReflect.Descriptor instead.
+func (*LeaveRoomRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{16}
+}
+
+type KickUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *KickUserReq) Reset() {
+	*x = KickUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserReq) ProtoMessage() {}
+
+func (x *KickUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserReq.ProtoReflect.Descriptor instead.
+func (*KickUserReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *KickUserReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type KickUserRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *KickUserRes) Reset() {
+	*x = KickUserRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickUserRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserRes) ProtoMessage() {}
+
+func (x *KickUserRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserRes.ProtoReflect.Descriptor instead.
+func (*KickUserRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{18}
+}
+
+type UpdateRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity       int32    `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"` // Defaults to the max room size
+	Public         bool     `protobuf:"varint,3,opt,name=public,proto3" json:"public,omitempty"`
+	Tags           []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	ReadyQuorum    int32    `protobuf:"varint,5,opt,name=ready_quorum,json=readyQuorum,proto3" json:"ready_quorum,omitempty"`          // Zero means every user must be ready
+	SpectatorDelay int32    `protobuf:"varint,6,opt,name=spectator_delay,json=spectatorDelay,proto3" json:"spectator_delay,omitempty"` // Seconds
+}
+
+func (x *UpdateRoomReq) Reset() {
+	*x = UpdateRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomReq) ProtoMessage() {}
+
+func (x *UpdateRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomReq.ProtoReflect.Descriptor instead.
+func (*UpdateRoomReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateRoomReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoomReq) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *UpdateRoomReq) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *UpdateRoomReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateRoomReq) GetReadyQuorum() int32 {
+	if x != nil {
+		return x.ReadyQuorum
+	}
+	return 0
+}
+
+func (x *UpdateRoomReq) GetSpectatorDelay() int32 {
+	if x != nil {
+		return x.SpectatorDelay
+	}
+	return 0
+}
+
+type UpdateRoomRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Room *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+}
+
+func (x *UpdateRoomRes) Reset() {
+	*x = UpdateRoomRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoomRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomRes) ProtoMessage() {}
+
+func (x *UpdateRoomRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomRes.ProtoReflect.Descriptor instead.
+func (*UpdateRoomRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateRoomRes) GetRoom() *RoomInfo {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type StartGameReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartGameReq) Reset() {
+	*x = StartGameReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartGameReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameReq) ProtoMessage() {}
+
+func (x *StartGameReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameReq.ProtoReflect.Descriptor instead.
+func (*StartGameReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{21}
+}
+
+type StartGameRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartGameRes) Reset() {
+	*x = StartGameRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartGameRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameRes) ProtoMessage() {}
+
+func (x *StartGameRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameRes.ProtoReflect.Descriptor instead.
+func (*StartGameRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{22}
+}
+
+type RoomEventsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RoomEventsReq) Reset() {
+	*x = RoomEventsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomEventsReq) ProtoMessage() {}
+
+func (x *RoomEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomEventsReq.ProtoReflect.Descriptor instead.
+func (*RoomEventsReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{23}
+}
+
+type RoomEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq  int64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`   // Increases by one for every event in the room
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"` // Unix time in milliseconds
+	// Types that are assignable to Event:
+	//	*RoomEvent_UserJoined
+	//	*RoomEvent_UserLeft
+	//	*RoomEvent_UserKicked
+	//	*RoomEvent_HostChanged
+	//	*RoomEvent_SettingsChanged
+	//	*RoomEvent_GameStarted
+	//	*RoomEvent_ReadyChanged
+	//	*RoomEvent_CountdownStarted
+	//	*RoomEvent_CountdownCancelled
+	//	*RoomEvent_UserDisconnected
+	//	*RoomEvent_UserReconnected
+	//	*RoomEvent_RoomClosed
+	//	*RoomEvent_ChatMessage
+	//	*RoomEvent_Snapshot
+	Event isRoomEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *RoomEvent) Reset() {
+	*x = RoomEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomEvent) ProtoMessage() {}
+
+func (x *RoomEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomEvent.ProtoReflect.Descriptor instead.
+func (*RoomEvent) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RoomEvent) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *RoomEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (m *RoomEvent) GetEvent() isRoomEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetUserJoined() *User {
+	if x, ok := x.GetEvent().(*RoomEvent_UserJoined); ok {
+		return x.UserJoined
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetUserLeft() *User {
+	if x, ok := x.GetEvent().(*RoomEvent_UserLeft); ok {
+		return x.UserLeft
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetUserKicked() *User {
+	if x, ok := x.GetEvent().(*RoomEvent_UserKicked); ok {
+		return x.UserKicked
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetHostChanged() *User {
+	if x, ok := x.GetEvent().(*RoomEvent_HostChanged); ok {
+		return x.HostChanged
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetSettingsChanged() *RoomInfo {
+	if x, ok := x.GetEvent().(*RoomEvent_SettingsChanged); ok {
+		return x.SettingsChanged
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetGameStarted() *GameStarted {
+	if x, ok := x.GetEvent().(*RoomEvent_GameStarted); ok {
+		return x.GameStarted
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetReadyChanged() *ReadyChanged {
+	if x, ok := x.GetEvent().(*RoomEvent_ReadyChanged); ok {
+		return x.ReadyChanged
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetCountdownStarted() *CountdownStarted {
+	if x, ok := x.GetEvent().(*RoomEvent_CountdownStarted); ok {
+		return x.CountdownStarted
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetCountdownCancelled() *CountdownCancelled {
+	if x, ok := x.GetEvent().(*RoomEvent_CountdownCancelled); ok {
+		return x.CountdownCancelled
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetUserDisconnected() *User {
+	if x, ok := x.GetEvent().(*RoomEvent_UserDisconnected); ok {
+		return x.UserDisconnected
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetUserReconnected() *User {
+	if x, ok := x.GetEvent().(*RoomEvent_UserReconnected); ok {
+		return x.UserReconnected
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetRoomClosed() *RoomClosed {
+	if x, ok := x.GetEvent().(*RoomEvent_RoomClosed); ok {
+		return x.RoomClosed
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetChatMessage() *ChatMessage {
+	if x, ok := x.GetEvent().(*RoomEvent_ChatMessage); ok {
+		return x.ChatMessage
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetSnapshot() *GameSnapshot {
+	if x, ok := x.GetEvent().(*RoomEvent_Snapshot); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type isRoomEvent_Event interface {
+	isRoomEvent_Event()
+}
+
+type RoomEvent_UserJoined struct {
+	UserJoined *User `protobuf:"bytes,3,opt,name=user_joined,json=userJoined,proto3,oneof"`
+}
+
+type RoomEvent_UserLeft struct {
+	UserLeft *User `protobuf:"bytes,4,opt,name=user_left,json=userLeft,proto3,oneof"`
+}
+
+type RoomEvent_UserKicked struct {
+	UserKicked *User `protobuf:"bytes,5,opt,name=user_kicked,json=userKicked,proto3,oneof"`
+}
+
+type RoomEvent_HostChanged struct {
+	HostChanged *User `protobuf:"bytes,6,opt,name=host_changed,json=hostChanged,proto3,oneof"`
+}
+
+type RoomEvent_SettingsChanged struct {
+	SettingsChanged *RoomInfo `protobuf:"bytes,7,opt,name=settings_changed,json=settingsChanged,proto3,oneof"`
+}
+
+type RoomEvent_GameStarted struct {
+	GameStarted *GameStarted `protobuf:"bytes,8,opt,name=game_started,json=gameStarted,proto3,oneof"`
+}
+
+type RoomEvent_ReadyChanged struct {
+	ReadyChanged *ReadyChanged `protobuf:"bytes,9,opt,name=ready_changed,json=readyChanged,proto3,oneof"`
+}
+
+type RoomEvent_CountdownStarted struct {
+	CountdownStarted *CountdownStarted `protobuf:"bytes,10,opt,name=countdown_started,json=countdownStarted,proto3,oneof"`
+}
+
+type RoomEvent_CountdownCancelled struct {
+	CountdownCancelled *CountdownCancelled `protobuf:"bytes,11,opt,name=countdown_cancelled,json=countdownCancelled,proto3,oneof"`
+}
+
+type RoomEvent_UserDisconnected struct {
+	UserDisconnected *User `protobuf:"bytes,12,opt,name=user_disconnected,json=userDisconnected,proto3,oneof"`
+}
+
+type RoomEvent_UserReconnected struct {
+	UserReconnected *User `protobuf:"bytes,13,opt,name=user_reconnected,json=userReconnected,proto3,oneof"`
+}
+
+type RoomEvent_RoomClosed struct {
+	RoomClosed *RoomClosed `protobuf:"bytes,14,opt,name=room_closed,json=roomClosed,proto3,oneof"`
+}
+
+type RoomEvent_ChatMessage struct {
+	ChatMessage *ChatMessage `protobuf:"bytes,15,opt,name=chat_message,json=chatMessage,proto3,oneof"`
+}
+
+type RoomEvent_Snapshot struct {
+	Snapshot *GameSnapshot `protobuf:"bytes,16,opt,name=snapshot,proto3,oneof"` // Sent every tick while the game is in progress and not kept in the history, spectators get a full snapshot every 100ms
+}
+
+func (*RoomEvent_UserJoined) isRoomEvent_Event() {}
+
+func (*RoomEvent_UserLeft) isRoomEvent_Event() {}
+
+func (*RoomEvent_UserKicked) isRoomEvent_Event() {}
+
+func (*RoomEvent_HostChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_SettingsChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_GameStarted) isRoomEvent_Event() {}
+
+func (*RoomEvent_ReadyChanged) isRoomEvent_Event() {}
+
+func (*RoomEvent_CountdownStarted) isRoomEvent_Event() {}
+
+func (*RoomEvent_CountdownCancelled) isRoomEvent_Event() {}
+
+func (*RoomEvent_UserDisconnected) isRoomEvent_Event() {}
+
+func (*RoomEvent_UserReconnected) isRoomEvent_Event() {}
+
+func (*RoomEvent_RoomClosed) isRoomEvent_Event() {}
+
+func (*RoomEvent_ChatMessage) isRoomEvent_Event() {}
+
+func (*RoomEvent_Snapshot) isRoomEvent_Event() {}
+
+type GameStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GameStarted) Reset() {
+	*x = GameStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameStarted) ProtoMessage() {}
+
+func (x *GameStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameStarted.ProtoReflect.Descriptor instead.
+func (*GameStarted) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{25}
+}
+
+type Vec2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X float32 `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float32 `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *Vec2) Reset() {
+	*x = Vec2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vec2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vec2) ProtoMessage() {}
+
+func (x *Vec2) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vec2.ProtoReflect.Descriptor instead.
+func (*Vec2) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Vec2) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Vec2) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// Positions and velocities are quantized to integers, divide by 100 to get units.
+// In a delta snapshot the values of entities that are in the baseline are differences from the baseline,
+// entities that are not in the baseline have absolute values.
+type EntityState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the user that controls the entity
+	X         int32 `protobuf:"zigzag32,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y         int32 `protobuf:"zigzag32,3,opt,name=y,proto3" json:"y,omitempty"`
+	VelocityX int32 `protobuf:"zigzag32,4,opt,name=velocity_x,json=velocityX,proto3" json:"velocity_x,omitempty"` // Units per second
+	VelocityY int32 `protobuf:"zigzag32,5,opt,name=velocity_y,json=velocityY,proto3" json:"velocity_y,omitempty"`
+	InputSeq  int64 `protobuf:"varint,6,opt,name=input_seq,json=inputSeq,proto3" json:"input_seq,omitempty"` // Sequence number of the last input applied to the entity, used by the client to reconcile prediction
+}
+
+func (x *EntityState) Reset() {
+	*x = EntityState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityState) ProtoMessage() {}
+
+func (x *EntityState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityState.ProtoReflect.Descriptor instead.
+func (*EntityState) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EntityState) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EntityState) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *EntityState) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *EntityState) GetVelocityX() int32 {
+	if x != nil {
+		return x.VelocityX
+	}
+	return 0
+}
+
+func (x *EntityState) GetVelocityY() int32 {
+	if x != nil {
+		return x.VelocityY
+	}
+	return 0
+}
+
+func (x *EntityState) GetInputSeq() int64 {
+	if x != nil {
+		return x.InputSeq
+	}
+	return 0
+}
+
+// Snapshots only hold the entities within the view radius of the user's entity.
+type GameSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick         int64          `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`                                     // Increases by one every simulation tick
+	BaselineTick int64          `protobuf:"varint,2,opt,name=baseline_tick,json=baselineTick,proto3" json:"baseline_tick,omitempty"` // Tick of the acknowledged snapshot this is a delta against, zero for a full snapshot
+	Entities     []*EntityState `protobuf:"bytes,3,rep,name=entities,proto3" json:"entities,omitempty"`                              // Entities in view that changed since the baseline, every entity in view in a full snapshot
+	Removed      []int32        `protobuf:"varint,4,rep,packed,name=removed,proto3" json:"removed,omitempty"`                        // IDs of the entities in the baseline that have been removed or are out of view
+	Entered      []int32        `protobuf:"varint,5,rep,packed,name=entered,proto3" json:"entered,omitempty"`                        // IDs of the entities that came into view since the previous snapshot
+	Left         []int32        `protobuf:"varint,6,rep,packed,name=left,proto3" json:"left,omitempty"`                              // IDs of the entities that left the view or were removed since the previous snapshot
+}
+
+func (x *GameSnapshot) Reset() {
+	*x = GameSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSnapshot) ProtoMessage() {}
+
+func (x *GameSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSnapshot.ProtoReflect.Descriptor instead.
+func (*GameSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GameSnapshot) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetBaselineTick() int64 {
+	if x != nil {
+		return x.BaselineTick
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetEntities() []*EntityState {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *GameSnapshot) GetRemoved() []int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *GameSnapshot) GetEntered() []int32 {
+	if x != nil {
+		return x.Entered
+	}
+	return nil
+}
+
+func (x *GameSnapshot) GetLeft() []int32 {
+	if x != nil {
+		return x.Left
+	}
+	return nil
+}
+
+type SetReadyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ready bool `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+}
+
+func (x *SetReadyReq) Reset() {
+	*x = SetReadyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReadyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReadyReq) ProtoMessage() {}
+
+func (x *SetReadyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReadyReq.ProtoReflect.Descriptor instead.
+func (*SetReadyReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetReadyReq) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+type SetReadyRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetReadyRes) Reset() {
+	*x = SetReadyRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReadyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReadyRes) ProtoMessage() {}
+
+func (x *SetReadyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReadyRes.ProtoReflect.Descriptor instead.
+func (*SetReadyRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{30}
+}
+
+type ReadyChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User  *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Ready bool  `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+}
+
+func (x *ReadyChanged) Reset() {
+	*x = ReadyChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadyChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyChanged) ProtoMessage() {}
+
+func (x *ReadyChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyChanged.ProtoReflect.Descriptor instead.
+func (*ReadyChanged) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReadyChanged) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ReadyChanged) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+type CountdownStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndsAt int64 `protobuf:"varint,1,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"` // Unix time in milliseconds
+}
+
+func (x *CountdownStarted) Reset() {
+	*x = CountdownStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountdownStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountdownStarted) ProtoMessage() {}
+
+func (x *CountdownStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountdownStarted.ProtoReflect.Descriptor instead.
+func (*CountdownStarted) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CountdownStarted) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+type CountdownCancelled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CountdownCancelled) Reset() {
+	*x = CountdownCancelled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountdownCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountdownCancelled) ProtoMessage() {}
+
+func (x *CountdownCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountdownCancelled.ProtoReflect.Descriptor instead.
+func (*CountdownCancelled) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{33}
+}
+
+type RoomClosed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RoomClosed) Reset() {
+	*x = RoomClosed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomClosed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomClosed) ProtoMessage() {}
+
+func (x *RoomClosed) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomClosed.ProtoReflect.Descriptor instead.
+func (*RoomClosed) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{34}
+}
+
+type ResumeRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SinceSeq int64 `protobuf:"varint,1,opt,name=since_seq,json=sinceSeq,proto3" json:"since_seq,omitempty"` // Events after this sequence number are replayed
+}
+
+func (x *ResumeRoomReq) Reset() {
+	*x = ResumeRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeRoomReq) ProtoMessage() {}
+
+func (x *ResumeRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeRoomReq.ProtoReflect.Descriptor instead.
+func (*ResumeRoomReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ResumeRoomReq) GetSinceSeq() int64 {
+	if x != nil {
+		return x.SinceSeq
+	}
+	return 0
+}
+
+type SpectateRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SpectateRoomReq) Reset() {
+	*x = SpectateRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpectateRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateRoomReq) ProtoMessage() {}
+
+func (x *SpectateRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateRoomReq.ProtoReflect.Descriptor instead.
+func (*SpectateRoomReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SpectateRoomReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RoomSnapshot is the state of a room saved to Redis so the room can be restored after a restart.
+type RoomSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version     int32          `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // Snapshot format version
+	Room        *RoomInfo      `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	HostId      int32          `protobuf:"varint,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Seq         int64          `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	Members     []*RoomMember  `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
+	ChatHistory []*ChatMessage `protobuf:"bytes,6,rep,name=chat_history,json=chatHistory,proto3" json:"chat_history,omitempty"`
+	Game        *GameSnapshot  `protobuf:"bytes,7,opt,name=game,proto3" json:"game,omitempty"` // State of the game when the room is in progress
+}
+
+func (x *RoomSnapshot) Reset() {
+	*x = RoomSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomSnapshot) ProtoMessage() {}
+
+func (x *RoomSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomSnapshot.ProtoReflect.Descriptor instead.
+func (*RoomSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RoomSnapshot) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RoomSnapshot) GetRoom() *RoomInfo {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *RoomSnapshot) GetHostId() int32 {
+	if x != nil {
+		return x.HostId
+	}
+	return 0
+}
+
+func (x *RoomSnapshot) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *RoomSnapshot) GetMembers() []*RoomMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *RoomSnapshot) GetChatHistory() []*ChatMessage {
+	if x != nil {
+		return x.ChatHistory
+	}
+	return nil
+}
+
+func (x *RoomSnapshot) GetGame() *GameSnapshot {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type RoomMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User     *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Ready    bool  `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	JoinedAt int64 `protobuf:"varint,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"` // Unix time in nanoseconds
+}
+
+func (x *RoomMember) Reset() {
+	*x = RoomMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomMember) ProtoMessage() {}
+
+func (x *RoomMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomMember.ProtoReflect.Descriptor instead.
+func (*RoomMember) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RoomMember) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *RoomMember) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *RoomMember) GetJoinedAt() int64 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+type SendChatReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *SendChatReq) Reset() {
+	*x = SendChatReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatReq) ProtoMessage() {}
+
+func (x *SendChatReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatReq.ProtoReflect.Descriptor instead.
+func (*SendChatReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SendChatReq) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SendChatRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SendChatRes) Reset() {
+	*x = SendChatRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatRes) ProtoMessage() {}
+
+func (x *SendChatRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatRes.ProtoReflect.Descriptor instead.
+func (*SendChatRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{40}
+}
+
+type SendInputReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq     int64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`                        // Increases by one for every input the client sends
+	AckTick int64 `protobuf:"varint,4,opt,name=ack_tick,json=ackTick,proto3" json:"ack_tick,omitempty"` // Tick of the last snapshot the client received, later snapshots are sent as deltas against it
+	// Types that are assignable to Move:
+	//	*SendInputReq_Direction
+	//	*SendInputReq_Target
+	Move isSendInputReq_Move `protobuf_oneof:"move"`
+}
+
+func (x *SendInputReq) Reset() {
+	*x = SendInputReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendInputReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendInputReq) ProtoMessage() {}
+
+func (x *SendInputReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendInputReq.ProtoReflect.Descriptor instead.
+func (*SendInputReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SendInputReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *SendInputReq) GetAckTick() int64 {
+	if x != nil {
+		return x.AckTick
+	}
+	return 0
+}
+
+func (m *SendInputReq) GetMove() isSendInputReq_Move {
+	if m != nil {
+		return m.Move
+	}
+	return nil
+}
+
+func (x *SendInputReq) GetDirection() *Vec2 {
+	if x, ok := x.GetMove().(*SendInputReq_Direction); ok {
+		return x.Direction
+	}
+	return nil
+}
+
+func (x *SendInputReq) GetTarget() *Vec2 {
+	if x, ok := x.GetMove().(*SendInputReq_Target); ok {
+		return x.Target
+	}
+	return nil
+}
+
+type isSendInputReq_Move interface {
+	isSendInputReq_Move()
+}
+
+type SendInputReq_Direction struct {
+	Direction *Vec2 `protobuf:"bytes,2,opt,name=direction,proto3,oneof"` // Move in a direction, directions longer than one are normalized
+}
+
+type SendInputReq_Target struct {
+	Target *Vec2 `protobuf:"bytes,3,opt,name=target,proto3,oneof"` // Move to a point in the world, the server finds a path around terrain that can not be walked on
+}
+
+func (*SendInputReq_Direction) isSendInputReq_Move() {}
+
+func (*SendInputReq_Target) isSendInputReq_Move() {}
+
+type SendInputRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SendInputRes) Reset() {
+	*x = SendInputRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendInputRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendInputRes) ProtoMessage() {}
+
+func (x *SendInputRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendInputRes.ProtoReflect.Descriptor instead.
+func (*SendInputRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{42}
+}
+
+// Acknowledges a snapshot without sending an input, for players that are standing still.
+type AckSnapshotReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick int64 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"` // Tick of the last snapshot the client received, later snapshots are sent as deltas against it
+}
+
+func (x *AckSnapshotReq) Reset() {
+	*x = AckSnapshotReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckSnapshotReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckSnapshotReq) ProtoMessage() {}
+
+func (x *AckSnapshotReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckSnapshotReq.ProtoReflect.Descriptor instead.
+func (*AckSnapshotReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AckSnapshotReq) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+type AckSnapshotRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AckSnapshotRes) Reset() {
+	*x = AckSnapshotRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckSnapshotRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckSnapshotRes) ProtoMessage() {}
+
+func (x *AckSnapshotRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckSnapshotRes.ProtoReflect.Descriptor instead.
+func (*AckSnapshotRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{44}
+}
+
+type GetNetStatsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetNetStatsReq) Reset() {
+	*x = GetNetStatsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNetStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetStatsReq) ProtoMessage() {}
+
+func (x *GetNetStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetStatsReq.ProtoReflect.Descriptor instead.
+func (*GetNetStatsReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{45}
+}
+
+type GetNetStatsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clients []*ClientNetStats `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+}
+
+func (x *GetNetStatsRes) Reset() {
+	*x = GetNetStatsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNetStatsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetStatsRes) ProtoMessage() {}
+
+func (x *GetNetStatsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetStatsRes.ProtoReflect.Descriptor instead.
+func (*GetNetStatsRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetNetStatsRes) GetClients() []*ClientNetStats {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+// Snapshot bandwidth of a user in the room over the last few seconds.
+type ClientNetStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User               *User   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	BytesPerSecond     float32 `protobuf:"fixed32,2,opt,name=bytes_per_second,json=bytesPerSecond,proto3" json:"bytes_per_second,omitempty"`               // Snapshot bytes sent
+	FullBytesPerSecond float32 `protobuf:"fixed32,3,opt,name=full_bytes_per_second,json=fullBytesPerSecond,proto3" json:"full_bytes_per_second,omitempty"` // Snapshot bytes that would have been sent without delta compression or interest filtering
+	FullSnapshots      int64   `protobuf:"varint,4,opt,name=full_snapshots,json=fullSnapshots,proto3" json:"full_snapshots,omitempty"`                     // Num full snapshots sent since the game started
+	DeltaSnapshots     int64   `protobuf:"varint,5,opt,name=delta_snapshots,json=deltaSnapshots,proto3" json:"delta_snapshots,omitempty"`                  // Num delta snapshots sent since the game started
+}
+
+func (x *ClientNetStats) Reset() {
+	*x = ClientNetStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientNetStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientNetStats) ProtoMessage() {}
+
+func (x *ClientNetStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientNetStats.ProtoReflect.Descriptor instead.
+func (*ClientNetStats) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ClientNetStats) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ClientNetStats) GetBytesPerSecond() float32 {
+	if x != nil {
+		return x.BytesPerSecond
+	}
+	return 0
+}
+
+func (x *ClientNetStats) GetFullBytesPerSecond() float32 {
+	if x != nil {
+		return x.FullBytesPerSecond
+	}
+	return 0
+}
+
+func (x *ClientNetStats) GetFullSnapshots() int64 {
+	if x != nil {
+		return x.FullSnapshots
+	}
+	return 0
+}
+
+func (x *ClientNetStats) GetDeltaSnapshots() int64 {
+	if x != nil {
+		return x.DeltaSnapshots
+	}
+	return 0
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq    int64  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`   // Sequence number of the room event that carried the message
+	Time   int64  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"` // Unix time in milliseconds
+	Sender *User  `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Text   string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ChatMessage) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetSender() *User {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ChannelInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ModeratorOnly bool   `protobuf:"varint,2,opt,name=moderator_only,json=moderatorOnly,proto3" json:"moderator_only,omitempty"`
+	Joined        bool   `protobuf:"varint,3,opt,name=joined,proto3" json:"joined,omitempty"`
+}
+
+func (x *ChannelInfo) Reset() {
+	*x = ChannelInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelInfo) ProtoMessage() {}
+
+func (x *ChannelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelInfo.ProtoReflect.Descriptor instead.
+func (*ChannelInfo) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ChannelInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChannelInfo) GetModeratorOnly() bool {
+	if x != nil {
+		return x.ModeratorOnly
+	}
+	return false
+}
+
+func (x *ChannelInfo) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+type ChannelMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sender  *User  `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Text    string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Time    int64  `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"` // Unix time in milliseconds
+}
+
+func (x *ChannelMessage) Reset() {
+	*x = ChannelMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelMessage) ProtoMessage() {}
+
+func (x *ChannelMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelMessage.ProtoReflect.Descriptor instead.
+func (*ChannelMessage) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ChannelMessage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChannelMessage) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ChannelMessage) GetSender() *User {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *ChannelMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChannelMessage) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type ListChannelsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListChannelsReq) Reset() {
+	*x = ListChannelsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChannelsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelsReq) ProtoMessage() {}
+
+func (x *ListChannelsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelsReq.ProtoReflect.Descriptor instead.
+func (*ListChannelsReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{51}
+}
+
+type ListChannelsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channels []*ChannelInfo `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *ListChannelsRes) Reset() {
+	*x = ListChannelsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChannelsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelsRes) ProtoMessage() {}
+
+func (x *ListChannelsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelsRes.ProtoReflect.Descriptor instead.
+func (*ListChannelsRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListChannelsRes) GetChannels() []*ChannelInfo {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type JoinChannelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (x *JoinChannelReq) Reset() {
+	*x = JoinChannelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinChannelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinChannelReq) ProtoMessage() {}
+
+func (x *JoinChannelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinChannelReq.ProtoReflect.Descriptor instead.
+func (*JoinChannelReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *JoinChannelReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type JoinChannelRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *JoinChannelRes) Reset() {
+	*x = JoinChannelRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinChannelRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinChannelRes) ProtoMessage() {}
+
+func (x *JoinChannelRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinChannelRes.ProtoReflect.Descriptor instead.
+func (*JoinChannelRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{54}
+}
+
+type LeaveChannelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (x *LeaveChannelReq) Reset() {
+	*x = LeaveChannelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveChannelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveChannelReq) ProtoMessage() {}
+
+func (x *LeaveChannelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveChannelReq.ProtoReflect.Descriptor instead.
+func (*LeaveChannelReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *LeaveChannelReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type LeaveChannelRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveChannelRes) Reset() {
+	*x = LeaveChannelRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveChannelRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveChannelRes) ProtoMessage() {}
+
+func (x *LeaveChannelRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveChannelRes.ProtoReflect.Descriptor instead.
+func (*LeaveChannelRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{56}
+}
+
+type SendChannelMessageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Text    string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *SendChannelMessageReq) Reset() {
+	*x = SendChannelMessageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChannelMessageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChannelMessageReq) ProtoMessage() {}
+
+func (x *SendChannelMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChannelMessageReq.ProtoReflect.Descriptor instead.
+func (*SendChannelMessageReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SendChannelMessageReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SendChannelMessageReq) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SendChannelMessageRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *ChannelMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SendChannelMessageRes) Reset() {
+	*x = SendChannelMessageRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChannelMessageRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChannelMessageRes) ProtoMessage() {}
+
+func (x *SendChannelMessageRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChannelMessageRes.ProtoReflect.Descriptor instead.
+func (*SendChannelMessageRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SendChannelMessageRes) GetMessage() *ChannelMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type GetChannelHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Cursor  string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // Empty starts from the newest message
+	Limit   int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetChannelHistoryReq) Reset() {
+	*x = GetChannelHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelHistoryReq) ProtoMessage() {}
+
+func (x *GetChannelHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetChannelHistoryReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetChannelHistoryReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *GetChannelHistoryReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetChannelHistoryReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetChannelHistoryRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages   []*ChannelMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`                       // Newest message first
+	NextCursor string            `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty when there are no older messages
+}
+
+func (x *GetChannelHistoryRes) Reset() {
+	*x = GetChannelHistoryRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelHistoryRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelHistoryRes) ProtoMessage() {}
+
+func (x *GetChannelHistoryRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelHistoryRes.ProtoReflect.Descriptor instead.
+func (*GetChannelHistoryRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetChannelHistoryRes) GetMessages() []*ChannelMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetChannelHistoryRes) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ChannelMessagesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChannelMessagesReq) Reset() {
+	*x = ChannelMessagesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelMessagesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelMessagesReq) ProtoMessage() {}
+
+func (x *ChannelMessagesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelMessagesReq.ProtoReflect.Descriptor instead.
+func (*ChannelMessagesReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{61}
+}
+
+type DirectMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender    *User  `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient *User  `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Text      string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Time      int64  `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"` // Unix time in milliseconds
+}
+
+func (x *DirectMessage) Reset() {
+	*x = DirectMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DirectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectMessage) ProtoMessage() {}
+
+func (x *DirectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectMessage.ProtoReflect.Descriptor instead.
+func (*DirectMessage) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DirectMessage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DirectMessage) GetSender() *User {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *DirectMessage) GetRecipient() *User {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+func (x *DirectMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DirectMessage) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type SendDirectMessageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Recipient:
+	//	*SendDirectMessageReq_UserId
+	//	*SendDirectMessageReq_Name
+	Recipient isSendDirectMessageReq_Recipient `protobuf_oneof:"recipient"`
+	Text      string                           `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *SendDirectMessageReq) Reset() {
+	*x = SendDirectMessageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendDirectMessageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendDirectMessageReq) ProtoMessage() {}
+
+func (x *SendDirectMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendDirectMessageReq.ProtoReflect.Descriptor instead.
+func (*SendDirectMessageReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{63}
+}
+
+func (m *SendDirectMessageReq) GetRecipient() isSendDirectMessageReq_Recipient {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (x *SendDirectMessageReq) GetUserId() int32 {
+	if x, ok := x.GetRecipient().(*SendDirectMessageReq_UserId); ok {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SendDirectMessageReq) GetName() string {
+	if x, ok := x.GetRecipient().(*SendDirectMessageReq_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SendDirectMessageReq) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type isSendDirectMessageReq_Recipient interface {
+	isSendDirectMessageReq_Recipient()
+}
+
+type SendDirectMessageReq_UserId struct {
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type SendDirectMessageReq_Name struct {
+	Name string `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
+}
+
+func (*SendDirectMessageReq_UserId) isSendDirectMessageReq_Recipient() {}
+
+func (*SendDirectMessageReq_Name) isSendDirectMessageReq_Recipient() {}
+
+type SendDirectMessageRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *DirectMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SendDirectMessageRes) Reset() {
+	*x = SendDirectMessageRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendDirectMessageRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendDirectMessageRes) ProtoMessage() {}
+
+func (x *SendDirectMessageRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendDirectMessageRes.ProtoReflect.Descriptor instead.
+func (*SendDirectMessageRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SendDirectMessageRes) GetMessage() *DirectMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Unread messages are sent first, oldest message first, followed by new messages as they arrive.
+type DirectMessagesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DirectMessagesReq) Reset() {
+	*x = DirectMessagesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DirectMessagesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectMessagesReq) ProtoMessage() {}
+
+func (x *DirectMessagesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectMessagesReq.ProtoReflect.Descriptor instead.
+func (*DirectMessagesReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{65}
+}
+
+type UnreadCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender *User `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Count  int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *UnreadCount) Reset() {
+	*x = UnreadCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnreadCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadCount) ProtoMessage() {}
+
+func (x *UnreadCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadCount.ProtoReflect.Descriptor instead.
+func (*UnreadCount) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UnreadCount) GetSender() *User {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *UnreadCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetUnreadCountsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetUnreadCountsReq) Reset() {
+	*x = GetUnreadCountsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUnreadCountsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountsReq) ProtoMessage() {}
+
+func (x *GetUnreadCountsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountsReq.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountsReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{67}
+}
+
+type GetUnreadCountsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counts []*UnreadCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (x *GetUnreadCountsRes) Reset() {
+	*x = GetUnreadCountsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUnreadCountsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountsRes) ProtoMessage() {}
+
+func (x *GetUnreadCountsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountsRes.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountsRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetUnreadCountsRes) GetCounts() []*UnreadCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type MarkDirectMessagesReadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderId int32 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	UpToId   int64 `protobuf:"varint,2,opt,name=up_to_id,json=upToId,proto3" json:"up_to_id,omitempty"` // Zero marks every message from the sender as read
+}
+
+func (x *MarkDirectMessagesReadReq) Reset() {
+	*x = MarkDirectMessagesReadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkDirectMessagesReadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkDirectMessagesReadReq) ProtoMessage() {}
+
+func (x *MarkDirectMessagesReadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkDirectMessagesReadReq.ProtoReflect.Descriptor instead.
+func (*MarkDirectMessagesReadReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *MarkDirectMessagesReadReq) GetSenderId() int32 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *MarkDirectMessagesReadReq) GetUpToId() int64 {
+	if x != nil {
+		return x.UpToId
+	}
+	return 0
+}
+
+type MarkDirectMessagesReadRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MarkDirectMessagesReadRes) Reset() {
+	*x = MarkDirectMessagesReadRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkDirectMessagesReadRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkDirectMessagesReadRes) ProtoMessage() {}
+
+func (x *MarkDirectMessagesReadRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkDirectMessagesReadRes.ProtoReflect.Descriptor instead.
+func (*MarkDirectMessagesReadRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{70}
+}
+
+type Friend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User   *User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Online bool   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	RoomId string `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"` // Empty if the friend is not in a room
+}
+
+func (x *Friend) Reset() {
+	*x = Friend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Friend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Friend) ProtoMessage() {}
+
+func (x *Friend) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Friend.ProtoReflect.Descriptor instead.
+func (*Friend) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Friend) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Friend) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *Friend) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type SendFriendRequestReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Recipient:
+	//	*SendFriendRequestReq_UserId
+	//	*SendFriendRequestReq_Name
+	Recipient isSendFriendRequestReq_Recipient `protobuf_oneof:"recipient"`
+}
+
+func (x *SendFriendRequestReq) Reset() {
+	*x = SendFriendRequestReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendFriendRequestReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestReq) ProtoMessage() {}
+
+func (x *SendFriendRequestReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestReq.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{72}
+}
+
+func (m *SendFriendRequestReq) GetRecipient() isSendFriendRequestReq_Recipient {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (x *SendFriendRequestReq) GetUserId() int32 {
+	if x, ok := x.GetRecipient().(*SendFriendRequestReq_UserId); ok {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SendFriendRequestReq) GetName() string {
+	if x, ok := x.GetRecipient().(*SendFriendRequestReq_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+type isSendFriendRequestReq_Recipient interface {
+	isSendFriendRequestReq_Recipient()
+}
+
+type SendFriendRequestReq_UserId struct {
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type SendFriendRequestReq_Name struct {
+	Name string `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
+}
+
+func (*SendFriendRequestReq_UserId) isSendFriendRequestReq_Recipient() {}
+
+func (*SendFriendRequestReq_Name) isSendFriendRequestReq_Recipient() {}
+
+type SendFriendRequestRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accepted bool `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"` // True if the other user had already sent a request, the users are now friends
+}
+
+func (x *SendFriendRequestRes) Reset() {
+	*x = SendFriendRequestRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendFriendRequestRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestRes) ProtoMessage() {}
+
+func (x *SendFriendRequestRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestRes.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SendFriendRequestRes) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+type AcceptFriendRequestReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *AcceptFriendRequestReq) Reset() {
+	*x = AcceptFriendRequestReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptFriendRequestReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFriendRequestReq) ProtoMessage() {}
+
+func (x *AcceptFriendRequestReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFriendRequestReq.ProtoReflect.Descriptor instead.
+func (*AcceptFriendRequestReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AcceptFriendRequestReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AcceptFriendRequestRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AcceptFriendRequestRes) Reset() {
+	*x = AcceptFriendRequestRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptFriendRequestRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFriendRequestRes) ProtoMessage() {}
+
+func (x *AcceptFriendRequestRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFriendRequestRes.ProtoReflect.Descriptor instead.
+func (*AcceptFriendRequestRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{75}
+}
+
+type DeclineFriendRequestReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *DeclineFriendRequestReq) Reset() {
+	*x = DeclineFriendRequestReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineFriendRequestReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineFriendRequestReq) ProtoMessage() {}
+
+func (x *DeclineFriendRequestReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineFriendRequestReq.ProtoReflect.Descriptor instead.
+func (*DeclineFriendRequestReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeclineFriendRequestReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeclineFriendRequestRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeclineFriendRequestRes) Reset() {
+	*x = DeclineFriendRequestRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineFriendRequestRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineFriendRequestRes) ProtoMessage() {}
+
+func (x *DeclineFriendRequestRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineFriendRequestRes.ProtoReflect.Descriptor instead.
+func (*DeclineFriendRequestRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{77}
+}
+
+type RemoveFriendReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RemoveFriendReq) Reset() {
+	*x = RemoveFriendReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFriendReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendReq) ProtoMessage() {}
+
+func (x *RemoveFriendReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendReq.ProtoReflect.Descriptor instead.
+func (*RemoveFriendReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RemoveFriendReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RemoveFriendRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveFriendRes) Reset() {
+	*x = RemoveFriendRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFriendRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendRes) ProtoMessage() {}
+
+func (x *RemoveFriendRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendRes.ProtoReflect.Descriptor instead.
+func (*RemoveFriendRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{79}
+}
+
+type ListFriendsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFriendsReq) Reset() {
+	*x = ListFriendsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFriendsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsReq) ProtoMessage() {}
+
+func (x *ListFriendsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsReq.ProtoReflect.Descriptor instead.
+func (*ListFriendsReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{80}
+}
+
+type ListFriendsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Friends          []*Friend `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	IncomingRequests []*User   `protobuf:"bytes,2,rep,name=incoming_requests,json=incomingRequests,proto3" json:"incoming_requests,omitempty"`
+	OutgoingRequests []*User   `protobuf:"bytes,3,rep,name=outgoing_requests,json=outgoingRequests,proto3" json:"outgoing_requests,omitempty"`
+}
+
+func (x *ListFriendsRes) Reset() {
+	*x = ListFriendsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFriendsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsRes) ProtoMessage() {}
+
+func (x *ListFriendsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsRes.ProtoReflect.Descriptor instead.
+func (*ListFriendsRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListFriendsRes) GetFriends() []*Friend {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+func (x *ListFriendsRes) GetIncomingRequests() []*User {
+	if x != nil {
+		return x.IncomingRequests
+	}
+	return nil
+}
+
+func (x *ListFriendsRes) GetOutgoingRequests() []*User {
+	if x != nil {
+		return x.OutgoingRequests
+	}
+	return nil
+}
+
+// The current presence of every friend is sent first, followed by changes as they happen.
+type PresenceUpdatesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PresenceUpdatesReq) Reset() {
+	*x = PresenceUpdatesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresenceUpdatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceUpdatesReq) ProtoMessage() {}
+
+func (x *PresenceUpdatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceUpdatesReq.ProtoReflect.Descriptor instead.
+func (*PresenceUpdatesReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{82}
+}
+
+type BlockUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to User:
+	//	*BlockUserReq_UserId
+	//	*BlockUserReq_Name
+	User isBlockUserReq_User `protobuf_oneof:"user"`
+}
+
+func (x *BlockUserReq) Reset() {
+	*x = BlockUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserReq) ProtoMessage() {}
+
+func (x *BlockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserReq.ProtoReflect.Descriptor instead.
+func (*BlockUserReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{83}
+}
+
+func (m *BlockUserReq) GetUser() isBlockUserReq_User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (x *BlockUserReq) GetUserId() int32 {
+	if x, ok := x.GetUser().(*BlockUserReq_UserId); ok {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BlockUserReq) GetName() string {
+	if x, ok := x.GetUser().(*BlockUserReq_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+type isBlockUserReq_User interface {
+	isBlockUserReq_User()
+}
+
+type BlockUserReq_UserId struct {
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type BlockUserReq_Name struct {
+	Name string `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
+}
+
+func (*BlockUserReq_UserId) isBlockUserReq_User() {}
+
+func (*BlockUserReq_Name) isBlockUserReq_User() {}
+
+type BlockUserRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BlockUserRes) Reset() {
+	*x = BlockUserRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRes) ProtoMessage() {}
+
+func (x *BlockUserRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRes.ProtoReflect.Descriptor instead.
+func (*BlockUserRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{84}
+}
+
+type UnblockUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *UnblockUserReq) Reset() {
+	*x = UnblockUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserReq) ProtoMessage() {}
+
+func (x *UnblockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserReq.ProtoReflect.Descriptor instead.
+func (*UnblockUserReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UnblockUserReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UnblockUserRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnblockUserRes) Reset() {
+	*x = UnblockUserRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUserRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRes) ProtoMessage() {}
+
+func (x *UnblockUserRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRes.ProtoReflect.Descriptor instead.
+func (*UnblockUserRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{86}
+}
+
+type ListBlockedReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBlockedReq) Reset() {
+	*x = ListBlockedReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlockedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedReq) ProtoMessage() {}
+
+func (x *ListBlockedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedReq.ProtoReflect.Descriptor instead.
+func (*ListBlockedReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{87}
+}
+
+type ListBlockedRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ListBlockedRes) Reset() {
+	*x = ListBlockedRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlockedRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedRes) ProtoMessage() {}
+
+func (x *ListBlockedRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedRes.ProtoReflect.Descriptor instead.
+func (*ListBlockedRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListBlockedRes) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type PartyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Leader    *User   `protobuf:"bytes,2,opt,name=leader,proto3" json:"leader,omitempty"`
+	Members   []*User `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"` // In the order they joined, the leader included
+	Invites   []*User `protobuf:"bytes,4,rep,name=invites,proto3" json:"invites,omitempty"`
+	CreatedAt int64   `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix time in milliseconds
+}
+
+func (x *PartyInfo) Reset() {
+	*x = PartyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyInfo) ProtoMessage() {}
+
+func (x *PartyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyInfo.ProtoReflect.Descriptor instead.
+func (*PartyInfo) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PartyInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PartyInfo) GetLeader() *User {
+	if x != nil {
+		return x.Leader
+	}
+	return nil
+}
+
+func (x *PartyInfo) GetMembers() []*User {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *PartyInfo) GetInvites() []*User {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+func (x *PartyInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type PartyEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Party *PartyInfo `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"` // Party after the event, empty when the party was disbanded
+	// Types that are assignable to Event:
+	//	*PartyEvent_Invited
+	//	*PartyEvent_MemberJoined
+	//	*PartyEvent_MemberLeft
+	//	*PartyEvent_LeaderChanged
+	//	*PartyEvent_InviteDeclined
+	//	*PartyEvent_JoinedRoom
+	//	*PartyEvent_Disbanded
+	Event isPartyEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *PartyEvent) Reset() {
+	*x = PartyEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyEvent) ProtoMessage() {}
+
+func (x *PartyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyEvent.ProtoReflect.Descriptor instead.
+func (*PartyEvent) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PartyEvent) GetParty() *PartyInfo {
+	if x != nil {
+		return x.Party
+	}
+	return nil
+}
+
+func (m *PartyEvent) GetEvent() isPartyEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *PartyEvent) GetInvited() *User {
+	if x, ok := x.GetEvent().(*PartyEvent_Invited); ok {
+		return x.Invited
+	}
+	return nil
+}
+
+func (x *PartyEvent) GetMemberJoined() *User {
+	if x, ok := x.GetEvent().(*PartyEvent_MemberJoined); ok {
+		return x.MemberJoined
+	}
+	return nil
+}
+
+func (x *PartyEvent) GetMemberLeft() *User {
+	if x, ok := x.GetEvent().(*PartyEvent_MemberLeft); ok {
+		return x.MemberLeft
+	}
+	return nil
+}
+
+func (x *PartyEvent) GetLeaderChanged() *User {
+	if x, ok := x.GetEvent().(*PartyEvent_LeaderChanged); ok {
+		return x.LeaderChanged
+	}
+	return nil
+}
+
+func (x *PartyEvent) GetInviteDeclined() *User {
+	if x, ok := x.GetEvent().(*PartyEvent_InviteDeclined); ok {
+		return x.InviteDeclined
+	}
+	return nil
+}
+
+func (x *PartyEvent) GetJoinedRoom() string {
+	if x, ok := x.GetEvent().(*PartyEvent_JoinedRoom); ok {
+		return x.JoinedRoom
+	}
+	return ""
+}
+
+func (x *PartyEvent) GetDisbanded() bool {
+	if x, ok := x.GetEvent().(*PartyEvent_Disbanded); ok {
+		return x.Disbanded
+	}
+	return false
+}
+
+type isPartyEvent_Event interface {
+	isPartyEvent_Event()
+}
+
+type PartyEvent_Invited struct {
+	Invited *User `protobuf:"bytes,2,opt,name=invited,proto3,oneof"` // Sent to the invited user as well as the members
+}
+
+type PartyEvent_MemberJoined struct {
+	MemberJoined *User `protobuf:"bytes,3,opt,name=member_joined,json=memberJoined,proto3,oneof"`
+}
+
+type PartyEvent_MemberLeft struct {
+	MemberLeft *User `protobuf:"bytes,4,opt,name=member_left,json=memberLeft,proto3,oneof"`
+}
+
+type PartyEvent_LeaderChanged struct {
+	LeaderChanged *User `protobuf:"bytes,5,opt,name=leader_changed,json=leaderChanged,proto3,oneof"`
+}
+
+type PartyEvent_InviteDeclined struct {
+	InviteDeclined *User `protobuf:"bytes,6,opt,name=invite_declined,json=inviteDeclined,proto3,oneof"`
+}
+
+type PartyEvent_JoinedRoom struct {
+	JoinedRoom string `protobuf:"bytes,7,opt,name=joined_room,json=joinedRoom,proto3,oneof"` // ID of the room the party was placed in
+}
+
+type PartyEvent_Disbanded struct {
+	Disbanded bool `protobuf:"varint,8,opt,name=disbanded,proto3,oneof"`
+}
+
+func (*PartyEvent_Invited) isPartyEvent_Event() {}
+
+func (*PartyEvent_MemberJoined) isPartyEvent_Event() {}
+
+func (*PartyEvent_MemberLeft) isPartyEvent_Event() {}
+
+func (*PartyEvent_LeaderChanged) isPartyEvent_Event() {}
+
+func (*PartyEvent_InviteDeclined) isPartyEvent_Event() {}
+
+func (*PartyEvent_JoinedRoom) isPartyEvent_Event() {}
+
+func (*PartyEvent_Disbanded) isPartyEvent_Event() {}
+
+type CreatePartyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreatePartyReq) Reset() {
+	*x = CreatePartyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePartyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartyReq) ProtoMessage() {}
+
+func (x *CreatePartyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartyReq.ProtoReflect.Descriptor instead.
+func (*CreatePartyReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{91}
+}
+
+type CreatePartyRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Party *PartyInfo `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
+}
+
+func (x *CreatePartyRes) Reset() {
+	*x = CreatePartyRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePartyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartyRes) ProtoMessage() {}
+
+func (x *CreatePartyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartyRes.ProtoReflect.Descriptor instead.
+func (*CreatePartyRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreatePartyRes) GetParty() *PartyInfo {
+	if x != nil {
+		return x.Party
+	}
+	return nil
+}
+
+type GetPartyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPartyReq) Reset() {
+	*x = GetPartyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPartyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartyReq) ProtoMessage() {}
+
+func (x *GetPartyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartyReq.ProtoReflect.Descriptor instead.
+func (*GetPartyReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{93}
+}
+
+type GetPartyRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Party *PartyInfo `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"` // Empty if the user is not in a party
+}
+
+func (x *GetPartyRes) Reset() {
+	*x = GetPartyRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPartyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartyRes) ProtoMessage() {}
+
+func (x *GetPartyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartyRes.ProtoReflect.Descriptor instead.
+func (*GetPartyRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetPartyRes) GetParty() *PartyInfo {
+	if x != nil {
+		return x.Party
+	}
+	return nil
+}
+
+type InviteToPartyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to User:
+	//	*InviteToPartyReq_UserId
+	//	*InviteToPartyReq_Name
+	User isInviteToPartyReq_User `protobuf_oneof:"user"`
+}
+
+func (x *InviteToPartyReq) Reset() {
+	*x = InviteToPartyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToPartyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToPartyReq) ProtoMessage() {}
+
+func (x *InviteToPartyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToPartyReq.ProtoReflect.Descriptor instead.
+func (*InviteToPartyReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{95}
+}
+
+func (m *InviteToPartyReq) GetUser() isInviteToPartyReq_User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (x *InviteToPartyReq) GetUserId() int32 {
+	if x, ok := x.GetUser().(*InviteToPartyReq_UserId); ok {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *InviteToPartyReq) GetName() string {
+	if x, ok := x.GetUser().(*InviteToPartyReq_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+type isInviteToPartyReq_User interface {
+	isInviteToPartyReq_User()
+}
+
+type InviteToPartyReq_UserId struct {
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type InviteToPartyReq_Name struct {
+	Name string `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
+}
+
+func (*InviteToPartyReq_UserId) isInviteToPartyReq_User() {}
+
+func (*InviteToPartyReq_Name) isInviteToPartyReq_User() {}
+
+type InviteToPartyRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InviteToPartyRes) Reset() {
+	*x = InviteToPartyRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToPartyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToPartyRes) ProtoMessage() {}
+
+func (x *InviteToPartyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToPartyRes.ProtoReflect.Descriptor instead.
+func (*InviteToPartyRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{96}
+}
+
+type AcceptPartyInviteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PartyId string `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+}
+
+func (x *AcceptPartyInviteReq) Reset() {
+	*x = AcceptPartyInviteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptPartyInviteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPartyInviteReq) ProtoMessage() {}
+
+func (x *AcceptPartyInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptPartyInviteReq.ProtoReflect.Descriptor instead.
+func (*AcceptPartyInviteReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *AcceptPartyInviteReq) GetPartyId() string {
+	if x != nil {
+		return x.PartyId
+	}
+	return ""
+}
+
+type AcceptPartyInviteRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Party *PartyInfo `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
+}
+
+func (x *AcceptPartyInviteRes) Reset() {
+	*x = AcceptPartyInviteRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptPartyInviteRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPartyInviteRes) ProtoMessage() {}
+
+func (x *AcceptPartyInviteRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptPartyInviteRes.ProtoReflect.Descriptor instead.
+func (*AcceptPartyInviteRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AcceptPartyInviteRes) GetParty() *PartyInfo {
+	if x != nil {
+		return x.Party
+	}
+	return nil
+}
+
+type DeclinePartyInviteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PartyId string `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+}
+
+func (x *DeclinePartyInviteReq) Reset() {
+	*x = DeclinePartyInviteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclinePartyInviteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclinePartyInviteReq) ProtoMessage() {}
+
+func (x *DeclinePartyInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclinePartyInviteReq.ProtoReflect.Descriptor instead.
+func (*DeclinePartyInviteReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DeclinePartyInviteReq) GetPartyId() string {
+	if x != nil {
+		return x.PartyId
+	}
+	return ""
+}
+
+type DeclinePartyInviteRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeclinePartyInviteRes) Reset() {
+	*x = DeclinePartyInviteRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclinePartyInviteRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclinePartyInviteRes) ProtoMessage() {}
+
+func (x *DeclinePartyInviteRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclinePartyInviteRes.ProtoReflect.Descriptor instead.
+func (*DeclinePartyInviteRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{100}
+}
+
+type LeavePartyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeavePartyReq) Reset() {
+	*x = LeavePartyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeavePartyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeavePartyReq) ProtoMessage() {}
+
+func (x *LeavePartyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeavePartyReq.ProtoReflect.Descriptor instead.
+func (*LeavePartyReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{101}
+}
+
+type LeavePartyRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeavePartyRes) Reset() {
+	*x = LeavePartyRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeavePartyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeavePartyRes) ProtoMessage() {}
+
+func (x *LeavePartyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeavePartyRes.ProtoReflect.Descriptor instead.
+func (*LeavePartyRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{102}
+}
+
+type TransferPartyLeaderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *TransferPartyLeaderReq) Reset() {
+	*x = TransferPartyLeaderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferPartyLeaderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPartyLeaderReq) ProtoMessage() {}
+
+func (x *TransferPartyLeaderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPartyLeaderReq.ProtoReflect.Descriptor instead.
+func (*TransferPartyLeaderReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *TransferPartyLeaderReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type TransferPartyLeaderRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TransferPartyLeaderRes) Reset() {
+	*x = TransferPartyLeaderRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferPartyLeaderRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPartyLeaderRes) ProtoMessage() {}
+
+func (x *TransferPartyLeaderRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPartyLeaderRes.ProtoReflect.Descriptor instead.
+func (*TransferPartyLeaderRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{104}
+}
+
+type PartyEventsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PartyEventsReq) Reset() {
+	*x = PartyEventsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartyEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyEventsReq) ProtoMessage() {}
+
+func (x *PartyEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyEventsReq.ProtoReflect.Descriptor instead.
+func (*PartyEventsReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{105}
+}
+
+type GuildInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tag       string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Motd      string `protobuf:"bytes,4,opt,name=motd,proto3" json:"motd,omitempty"` // Message of the day, only sent to members
+	Members   int32  `protobuf:"varint,5,opt,name=members,proto3" json:"members,omitempty"`
+	CreatedAt int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix time in milliseconds
+	Channel   string `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`                       // Name of the guild chat channel, only sent to members
+}
+
+func (x *GuildInfo) Reset() {
+	*x = GuildInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildInfo) ProtoMessage() {}
+
+func (x *GuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildInfo.ProtoReflect.Descriptor instead.
+func (*GuildInfo) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GuildInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GuildInfo) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GuildInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildInfo) GetMotd() string {
+	if x != nil {
+		return x.Motd
+	}
+	return ""
+}
+
+func (x *GuildInfo) GetMembers() int32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+func (x *GuildInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *GuildInfo) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type GuildMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User     *User     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Rank     GuildRank `protobuf:"varint,2,opt,name=rank,proto3,enum=proto.GuildRank" json:"rank,omitempty"`
+	JoinedAt int64     `protobuf:"varint,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"` // Unix time in milliseconds
+}
+
+func (x *GuildMember) Reset() {
+	*x = GuildMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuildMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildMember) ProtoMessage() {}
+
+func (x *GuildMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildMember.ProtoReflect.Descriptor instead.
+func (*GuildMember) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GuildMember) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GuildMember) GetRank() GuildRank {
+	if x != nil {
+		return x.Rank
+	}
+	return GuildRank_GUILD_RANK_MEMBER
+}
+
+func (x *GuildMember) GetJoinedAt() int64 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+type CreateGuildReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag  string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateGuildReq) Reset() {
+	*x = CreateGuildReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGuildReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuildReq) ProtoMessage() {}
+
+func (x *CreateGuildReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuildReq.ProtoReflect.Descriptor instead.
+func (*CreateGuildReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *CreateGuildReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *CreateGuildReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateGuildRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Guild *GuildInfo `protobuf:"bytes,1,opt,name=guild,proto3" json:"guild,omitempty"`
+}
+
+func (x *CreateGuildRes) Reset() {
+	*x = CreateGuildRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGuildRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuildRes) ProtoMessage() {}
+
+func (x *CreateGuildRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuildRes.ProtoReflect.Descriptor instead.
+func (*CreateGuildRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CreateGuildRes) GetGuild() *GuildInfo {
+	if x != nil {
+		return x.Guild
+	}
+	return nil
+}
+
+type GetGuildReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Guild:
+	//	*GetGuildReq_Id
+	//	*GetGuildReq_Tag
+	Guild isGetGuildReq_Guild `protobuf_oneof:"guild"`
+}
+
+func (x *GetGuildReq) Reset() {
+	*x = GetGuildReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGuildReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuildReq) ProtoMessage() {}
+
+func (x *GetGuildReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuildReq.ProtoReflect.Descriptor instead.
+func (*GetGuildReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{110}
+}
+
+func (m *GetGuildReq) GetGuild() isGetGuildReq_Guild {
+	if m != nil {
+		return m.Guild
+	}
+	return nil
+}
+
+func (x *GetGuildReq) GetId() int32 {
+	if x, ok := x.GetGuild().(*GetGuildReq_Id); ok {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetGuildReq) GetTag() string {
+	if x, ok := x.GetGuild().(*GetGuildReq_Tag); ok {
+		return x.Tag
+	}
+	return ""
+}
+
+type isGetGuildReq_Guild interface {
+	isGetGuildReq_Guild()
+}
+
+type GetGuildReq_Id struct {
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3,oneof"`
+}
+
+type GetGuildReq_Tag struct {
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3,oneof"`
+}
+
+func (*GetGuildReq_Id) isGetGuildReq_Guild() {}
+
+func (*GetGuildReq_Tag) isGetGuildReq_Guild() {}
+
+type GetGuildRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Guild        *GuildInfo     `protobuf:"bytes,1,opt,name=guild,proto3" json:"guild,omitempty"` // Empty if the user is not in a guild
+	Members      []*GuildMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Applications []*User        `protobuf:"bytes,3,rep,name=applications,proto3" json:"applications,omitempty"` // Only sent to officers and the leader
+	Invites      []*User        `protobuf:"bytes,4,rep,name=invites,proto3" json:"invites,omitempty"`           // Only sent to officers and the leader
+}
+
+func (x *GetGuildRes) Reset() {
+	*x = GetGuildRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGuildRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuildRes) ProtoMessage() {}
+
+func (x *GetGuildRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuildRes.ProtoReflect.Descriptor instead.
+func (*GetGuildRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetGuildRes) GetGuild() *GuildInfo {
+	if x != nil {
+		return x.Guild
+	}
+	return nil
+}
+
+func (x *GetGuildRes) GetMembers() []*GuildMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *GetGuildRes) GetApplications() []*User {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *GetGuildRes) GetInvites() []*User {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type InviteToGuildReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to User:
+	//	*InviteToGuildReq_UserId
+	//	*InviteToGuildReq_Name
+	User isInviteToGuildReq_User `protobuf_oneof:"user"`
+}
+
+func (x *InviteToGuildReq) Reset() {
+	*x = InviteToGuildReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToGuildReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToGuildReq) ProtoMessage() {}
+
+func (x *InviteToGuildReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToGuildReq.ProtoReflect.Descriptor instead.
+func (*InviteToGuildReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{112}
+}
+
+func (m *InviteToGuildReq) GetUser() isInviteToGuildReq_User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (x *InviteToGuildReq) GetUserId() int32 {
+	if x, ok := x.GetUser().(*InviteToGuildReq_UserId); ok {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *InviteToGuildReq) GetName() string {
+	if x, ok := x.GetUser().(*InviteToGuildReq_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+type isInviteToGuildReq_User interface {
+	isInviteToGuildReq_User()
+}
+
+type InviteToGuildReq_UserId struct {
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type InviteToGuildReq_Name struct {
+	Name string `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
+}
+
+func (*InviteToGuildReq_UserId) isInviteToGuildReq_User() {}
+
+func (*InviteToGuildReq_Name) isInviteToGuildReq_User() {}
+
+type InviteToGuildRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InviteToGuildRes) Reset() {
+	*x = InviteToGuildRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToGuildRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToGuildRes) ProtoMessage() {}
+
+func (x *InviteToGuildRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToGuildRes.ProtoReflect.Descriptor instead.
+func (*InviteToGuildRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{113}
+}
+
+type ListGuildInvitesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListGuildInvitesReq) Reset() {
+	*x = ListGuildInvitesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGuildInvitesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGuildInvitesReq) ProtoMessage() {}
+
+func (x *ListGuildInvitesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGuildInvitesReq.ProtoReflect.Descriptor instead.
+func (*ListGuildInvitesReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{114}
+}
+
+type ListGuildInvitesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Guilds []*GuildInfo `protobuf:"bytes,1,rep,name=guilds,proto3" json:"guilds,omitempty"`
+}
+
+func (x *ListGuildInvitesRes) Reset() {
+	*x = ListGuildInvitesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGuildInvitesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGuildInvitesRes) ProtoMessage() {}
+
+func (x *ListGuildInvitesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGuildInvitesRes.ProtoReflect.Descriptor instead.
+func (*ListGuildInvitesRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListGuildInvitesRes) GetGuilds() []*GuildInfo {
+	if x != nil {
+		return x.Guilds
+	}
+	return nil
+}
+
+type AcceptGuildInviteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId int32 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+}
+
+func (x *AcceptGuildInviteReq) Reset() {
+	*x = AcceptGuildInviteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptGuildInviteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptGuildInviteReq) ProtoMessage() {}
+
+func (x *AcceptGuildInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptGuildInviteReq.ProtoReflect.Descriptor instead.
+func (*AcceptGuildInviteReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *AcceptGuildInviteReq) GetGuildId() int32 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+type AcceptGuildInviteRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Guild *GuildInfo `protobuf:"bytes,1,opt,name=guild,proto3" json:"guild,omitempty"`
+}
+
+func (x *AcceptGuildInviteRes) Reset() {
+	*x = AcceptGuildInviteRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptGuildInviteRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptGuildInviteRes) ProtoMessage() {}
+
+func (x *AcceptGuildInviteRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptGuildInviteRes.ProtoReflect.Descriptor instead.
+func (*AcceptGuildInviteRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *AcceptGuildInviteRes) GetGuild() *GuildInfo {
+	if x != nil {
+		return x.Guild
+	}
+	return nil
+}
+
+type DeclineGuildInviteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId int32 `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+}
+
+func (x *DeclineGuildInviteReq) Reset() {
+	*x = DeclineGuildInviteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineGuildInviteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineGuildInviteReq) ProtoMessage() {}
+
+func (x *DeclineGuildInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineGuildInviteReq.ProtoReflect.Descriptor instead.
+func (*DeclineGuildInviteReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *DeclineGuildInviteReq) GetGuildId() int32 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+type DeclineGuildInviteRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeclineGuildInviteRes) Reset() {
+	*x = DeclineGuildInviteRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineGuildInviteRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineGuildInviteRes) ProtoMessage() {}
+
+func (x *DeclineGuildInviteRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineGuildInviteRes.ProtoReflect.Descriptor instead.
+func (*DeclineGuildInviteRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{119}
+}
+
+type ApplyToGuildReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Guild:
+	//	*ApplyToGuildReq_Id
+	//	*ApplyToGuildReq_Tag
+	Guild isApplyToGuildReq_Guild `protobuf_oneof:"guild"`
+}
+
+func (x *ApplyToGuildReq) Reset() {
+	*x = ApplyToGuildReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyToGuildReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyToGuildReq) ProtoMessage() {}
+
+func (x *ApplyToGuildReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyToGuildReq.ProtoReflect.Descriptor instead.
+func (*ApplyToGuildReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{120}
+}
+
+func (m *ApplyToGuildReq) GetGuild() isApplyToGuildReq_Guild {
+	if m != nil {
+		return m.Guild
+	}
+	return nil
+}
+
+func (x *ApplyToGuildReq) GetId() int32 {
+	if x, ok := x.GetGuild().(*ApplyToGuildReq_Id); ok {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApplyToGuildReq) GetTag() string {
+	if x, ok := x.GetGuild().(*ApplyToGuildReq_Tag); ok {
+		return x.Tag
+	}
+	return ""
+}
+
+type isApplyToGuildReq_Guild interface {
+	isApplyToGuildReq_Guild()
+}
+
+type ApplyToGuildReq_Id struct {
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3,oneof"`
+}
+
+type ApplyToGuildReq_Tag struct {
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3,oneof"`
+}
+
+func (*ApplyToGuildReq_Id) isApplyToGuildReq_Guild() {}
+
+func (*ApplyToGuildReq_Tag) isApplyToGuildReq_Guild() {}
+
+type ApplyToGuildRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ApplyToGuildRes) Reset() {
+	*x = ApplyToGuildRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyToGuildRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyToGuildRes) ProtoMessage() {}
+
+func (x *ApplyToGuildRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyToGuildRes.ProtoReflect.Descriptor instead.
+func (*ApplyToGuildRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{121}
+}
+
+type AcceptGuildApplicationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *AcceptGuildApplicationReq) Reset() {
+	*x = AcceptGuildApplicationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptGuildApplicationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptGuildApplicationReq) ProtoMessage() {}
+
+func (x *AcceptGuildApplicationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptGuildApplicationReq.ProtoReflect.Descriptor instead.
+func (*AcceptGuildApplicationReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *AcceptGuildApplicationReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AcceptGuildApplicationRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AcceptGuildApplicationRes) Reset() {
+	*x = AcceptGuildApplicationRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptGuildApplicationRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptGuildApplicationRes) ProtoMessage() {}
+
+func (x *AcceptGuildApplicationRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptGuildApplicationRes.ProtoReflect.Descriptor instead.
+func (*AcceptGuildApplicationRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{123}
+}
+
+type DeclineGuildApplicationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *DeclineGuildApplicationReq) Reset() {
+	*x = DeclineGuildApplicationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineGuildApplicationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineGuildApplicationReq) ProtoMessage() {}
+
+func (x *DeclineGuildApplicationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineGuildApplicationReq.ProtoReflect.Descriptor instead.
+func (*DeclineGuildApplicationReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *DeclineGuildApplicationReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeclineGuildApplicationRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeclineGuildApplicationRes) Reset() {
+	*x = DeclineGuildApplicationRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineGuildApplicationRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineGuildApplicationRes) ProtoMessage() {}
+
+func (x *DeclineGuildApplicationRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineGuildApplicationRes.ProtoReflect.Descriptor instead.
+func (*DeclineGuildApplicationRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{125}
+}
+
+type LeaveGuildReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveGuildReq) Reset() {
+	*x = LeaveGuildReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveGuildReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGuildReq) ProtoMessage() {}
+
+func (x *LeaveGuildReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGuildReq.ProtoReflect.Descriptor instead.
+func (*LeaveGuildReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{126}
+}
+
+type LeaveGuildRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveGuildRes) Reset() {
+	*x = LeaveGuildRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveGuildRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGuildRes) ProtoMessage() {}
+
+func (x *LeaveGuildRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGuildRes.ProtoReflect.Descriptor instead.
+func (*LeaveGuildRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{127}
+}
+
+type KickGuildMemberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *KickGuildMemberReq) Reset() {
+	*x = KickGuildMemberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickGuildMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickGuildMemberReq) ProtoMessage() {}
+
+func (x *KickGuildMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickGuildMemberReq.ProtoReflect.Descriptor instead.
+func (*KickGuildMemberReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *KickGuildMemberReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type KickGuildMemberRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *KickGuildMemberRes) Reset() {
+	*x = KickGuildMemberRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickGuildMemberRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickGuildMemberRes) ProtoMessage() {}
+
+func (x *KickGuildMemberRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickGuildMemberRes.ProtoReflect.Descriptor instead.
+func (*KickGuildMemberRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{129}
+}
+
+type SetGuildRankReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32     `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rank   GuildRank `protobuf:"varint,2,opt,name=rank,proto3,enum=proto.GuildRank" json:"rank,omitempty"` // Making another member the leader makes the current leader an officer
+}
+
+func (x *SetGuildRankReq) Reset() {
+	*x = SetGuildRankReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGuildRankReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGuildRankReq) ProtoMessage() {}
+
+func (x *SetGuildRankReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGuildRankReq.ProtoReflect.Descriptor instead.
+func (*SetGuildRankReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *SetGuildRankReq) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetGuildRankReq) GetRank() GuildRank {
+	if x != nil {
+		return x.Rank
+	}
+	return GuildRank_GUILD_RANK_MEMBER
+}
+
+type SetGuildRankRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetGuildRankRes) Reset() {
+	*x = SetGuildRankRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGuildRankRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGuildRankRes) ProtoMessage() {}
+
+func (x *SetGuildRankRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGuildRankRes.ProtoReflect.Descriptor instead.
+func (*SetGuildRankRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{131}
+}
+
+type SetGuildMotdReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Motd string `protobuf:"bytes,1,opt,name=motd,proto3" json:"motd,omitempty"`
+}
+
+func (x *SetGuildMotdReq) Reset() {
+	*x = SetGuildMotdReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGuildMotdReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGuildMotdReq) ProtoMessage() {}
+
+func (x *SetGuildMotdReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGuildMotdReq.ProtoReflect.Descriptor instead.
+func (*SetGuildMotdReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *SetGuildMotdReq) GetMotd() string {
+	if x != nil {
+		return x.Motd
+	}
+	return ""
+}
+
+type SetGuildMotdRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetGuildMotdRes) Reset() {
+	*x = SetGuildMotdRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGuildMotdRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGuildMotdRes) ProtoMessage() {}
+
+func (x *SetGuildMotdRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGuildMotdRes.ProtoReflect.Descriptor instead.
+func (*SetGuildMotdRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{133}
+}
+
+type DisbandGuildReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisbandGuildReq) Reset() {
+	*x = DisbandGuildReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisbandGuildReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisbandGuildReq) ProtoMessage() {}
+
+func (x *DisbandGuildReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisbandGuildReq.ProtoReflect.Descriptor instead.
+func (*DisbandGuildReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{134}
+}
+
+type DisbandGuildRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisbandGuildRes) Reset() {
+	*x = DisbandGuildRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisbandGuildRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisbandGuildRes) ProtoMessage() {}
+
+func (x *DisbandGuildRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisbandGuildRes.ProtoReflect.Descriptor instead.
+func (*DisbandGuildRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{135}
+}
+
+type GetMapInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMapInfoReq) Reset() {
+	*x = GetMapInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMapInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMapInfoReq) ProtoMessage() {}
+
+func (x *GetMapInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMapInfoReq.ProtoReflect.Descriptor instead.
+func (*GetMapInfoReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{136}
+}
+
+type GetMapInfoRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Width     int32   `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`                          // Width of the map in chunks
+	Height    int32   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`                        // Height of the map in chunks
+	ChunkSize int32   `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"` // Width and height of a chunk in tiles
+	TileSize  float32 `protobuf:"fixed32,4,opt,name=tile_size,json=tileSize,proto3" json:"tile_size,omitempty"`   // Width and height of a tile in world units, the center of the map is at the center of the world
+	Seed      int64   `protobuf:"varint,5,opt,name=seed,proto3" json:"seed,omitempty"`                            // Seed the map was generated from, the same seed and biome always generate the same map
+	Biome     int32   `protobuf:"varint,6,opt,name=biome,proto3" json:"biome,omitempty"`                          // Version of the biome params the map was generated with, zero if the map was not generated
+}
+
+func (x *GetMapInfoRes) Reset() {
+	*x = GetMapInfoRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMapInfoRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMapInfoRes) ProtoMessage() {}
+
+func (x *GetMapInfoRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMapInfoRes.ProtoReflect.Descriptor instead.
+func (*GetMapInfoRes) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetMapInfoRes) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *GetMapInfoRes) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetMapInfoRes) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *GetMapInfoRes) GetTileSize() float32 {
+	if x != nil {
+		return x.TileSize
+	}
+	return 0
+}
+
+func (x *GetMapInfoRes) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *GetMapInfoRes) GetBiome() int32 {
+	if x != nil {
+		return x.Biome
+	}
+	return 0
+}
+
+type GetChunkReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X      int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"` // Chunk coordinates
+	Y      int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Radius int32 `protobuf:"varint,3,opt,name=radius,proto3" json:"radius,omitempty"` // Chunks within this many chunks of x,y are also sent, nearest first
+}
+
+func (x *GetChunkReq) Reset() {
+	*x = GetChunkReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChunkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChunkReq) ProtoMessage() {}
+
+func (x *GetChunkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChunkReq.ProtoReflect.Descriptor instead.
+func (*GetChunkReq) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetChunkReq) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *GetChunkReq) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *GetChunkReq) GetRadius() int32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+type MapChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X     int32  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y     int32  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Tiles []byte `protobuf:"bytes,3,opt,name=tiles,proto3" json:"tiles,omitempty"` // One Terrain value per tile, chunk_size rows of chunk_size tiles starting from the bottom row
+}
+
+func (x *MapChunk) Reset() {
+	*x = MapChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_granny_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapChunk) ProtoMessage() {}
+
+func (x *MapChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_granny_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapChunk.ProtoReflect.Descriptor instead.
+func (*MapChunk) Descriptor() ([]byte, []int) {
+	return file_proto_granny_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *MapChunk) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *MapChunk) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *MapChunk) GetTiles() []byte {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+var File_proto_granny_proto protoreflect.FileDescriptor
+
+var file_proto_granny_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x6e, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x0d, 0x53,
+	0x69, 0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x53, 0x69,
+	0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x0d,
+	0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x22, 0x73, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x49,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x75, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb9,
+	0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x72,
+	0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x0c,
+	0x63, 0x68, 0x61, 0x74, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x1a, 0x45, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2a, 0x0a, 0x04, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1d, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0b,
+	0x63, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x0f, 0x0a, 0x0d, 0x55,
+	0x73, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x22, 0xe0, 0x02, 0x0a,
+	0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12,
+	0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x71, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22,
+	0xb7, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x79, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d,
+	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x34, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x6f,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22,
+	0x93, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x56, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x0e, 0x0a,
+	0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x22, 0x0e, 0x0a,
+	0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x22, 0x26, 0x0a,
+	0x0b, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x71, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x79, 0x51,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x34,
+	0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x22, 0xd9, 0x06, 0x0a, 0x09, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6b, 0x69,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x4b,
+	0x69, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x68, 0x6f, 0x73, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x10, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e,
+	0x66, 0x6f, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x3a,
+	0x0a, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x11, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x4c, 0x0a, 0x13, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77,
+	0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x12, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x12, 0x3a, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x10,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x0c,
+	0x63, 0x68, 0x61, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x08,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x22, 0x22, 0x0a, 0x04, 0x56, 0x65, 0x63, 0x32, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x01, 0x79, 0x22, 0x94, 0x01, 0x0a, 0x0b, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x11, 0x52,
+	0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x11, 0x52, 0x01, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x11, 0x52, 0x09, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x58, 0x12,
+	0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x11, 0x52, 0x09, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x59, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x65, 0x71, 0x22, 0xbf, 0x01, 0x0a, 0x0c,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b,
+	0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x66,
+	0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x22, 0x23, 0x0a,
+	0x0b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x22, 0x0d, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65,
+	0x73, 0x22, 0x45, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x22, 0x2b, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65,
+	0x6e, 0x64, 0x73, 0x41, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f,
+	0x77, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0x0c, 0x0a, 0x0a, 0x52,
+	0x6f, 0x6f, 0x6d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x71, 0x22, 0x21, 0x0a, 0x0f, 0x53, 0x70, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x0c, 0x52,
+	0x6f, 0x6f, 0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x63, 0x68,
+	0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x04, 0x67, 0x61, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x04, 0x67, 0x61,
+	0x6d, 0x65, 0x22, 0x60, 0x0a, 0x0a, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x21, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x6b,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x63, 0x6b,
+	0x54, 0x69, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x56, 0x65, 0x63, 0x32, 0x48, 0x00, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x63, 0x32, 0x48, 0x00,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65,
+	0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x22, 0x24, 0x0a, 0x0e, 0x41, 0x63, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x22, 0x10, 0x0a, 0x0e, 0x41, 0x63, 0x6b, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4e,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x22, 0x41, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xde, 0x01,
+	0x0a, 0x0e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x66,
+	0x75, 0x6c, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x66, 0x75, 0x6c, 0x6c,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x75, 0x6c, 0x6c, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x6c,
+	0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x60, 0x0a, 0x0b,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x22, 0x87,
+	0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x22, 0x41, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2e,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x2a,
+	0x0a, 0x0e, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x10, 0x0a, 0x0e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x0f,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x15,
+	0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x22, 0x48, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6a, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x22,
+	0x97, 0x01, 0x0a, 0x0d, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x68, 0x0a, 0x14, 0x53, 0x65, 0x6e,
+	0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x19, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x22, 0x46, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x22, 0x48, 0x0a, 0x0b, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x22, 0x40, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x22, 0x52, 0x0a, 0x19, 0x4d, 0x61, 0x72, 0x6b, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x08,
+	0x75, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x70, 0x54, 0x6f, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x61, 0x72, 0x6b, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x06, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x1f, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22,
+	0x54, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x32, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x22, 0x31, 0x0a, 0x16, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x17, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e,
+	0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65,
+	0x63, 0x6c, 0x69, 0x6e, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x22, 0xad, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x73, 0x12, 0x38, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x11,
+	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x22, 0x47, 0x0a, 0x0c,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x06, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x0e, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x10, 0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x22, 0x33, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x09, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xfb, 0x02, 0x0a, 0x0a, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x12, 0x27, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00,
+	0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x0d, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x2e, 0x0a,
+	0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x34, 0x0a,
+	0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x64, 0x65,
+	0x63, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x6a,
+	0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1e,
+	0x0a, 0x09, 0x64, 0x69, 0x73, 0x62, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x09, 0x64, 0x69, 0x73, 0x62, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x07,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x22, 0x38, 0x0a, 0x0e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x22, 0x0d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x22, 0x35, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x22, 0x4b, 0x0a, 0x10, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x06,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x12, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x54, 0x6f, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x14, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x22, 0x3e, 0x0a,
+	0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x22, 0x32, 0x0a,
+	0x15, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49,
+	0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x22, 0x0f, 0x0a, 0x0d, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x16,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x18, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x22, 0xa8, 0x01, 0x0a, 0x09,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x74, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d,
+	0x6f, 0x74, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x71, 0x0a, 0x0b, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09,
+	0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0x36, 0x0a, 0x0e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x38, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64,
+	0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x3c, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x03,
+	0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x42, 0x07, 0x0a, 0x05, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x22, 0xbb, 0x01, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x75, 0x69,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x2f, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x25, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x22, 0x12, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x22,
+	0x3f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x73,
+	0x22, 0x31, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x67,
+	0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x67, 0x75,
+	0x69, 0x6c, 0x64, 0x22, 0x32, 0x0a, 0x15, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x63, 0x6c, 0x69,
+	0x6e, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x22, 0x40, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x67, 0x75, 0x69,
+	0x6c, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x1a, 0x44, 0x65, 0x63, 0x6c,
+	0x69, 0x6e, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x22, 0x0f, 0x0a,
+	0x0d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x22, 0x0f,
+	0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x22,
+	0x2d, 0x0a, 0x12, 0x4b, 0x69, 0x63, 0x6b, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x14,
+	0x0a, 0x12, 0x4b, 0x69, 0x63, 0x6b, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x22, 0x50, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64,
+	0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x61, 0x6e, 0x6b,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x0f, 0x53, 0x65, 0x74,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x6f, 0x74, 0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x6f, 0x74, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x74, 0x64,
+	0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x6f, 0x74, 0x64,
+	0x52, 0x65, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x62, 0x61, 0x6e, 0x64, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x62, 0x61, 0x6e,
+	0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x22, 0xa3, 0x01, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6c,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x74, 0x69,
+	0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x69,
+	0x6f, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x69, 0x6f, 0x6d, 0x65,
+	0x22, 0x41, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12,
+	0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a,
+	0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x64,
+	0x69, 0x75, 0x73, 0x22, 0x3c, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a,
+	0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65,
+	0x73, 0x2a, 0x56, 0x0a, 0x09, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x13,
+	0x0a, 0x0f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45,
+	0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12,
+	0x18, 0x0a, 0x14, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x2a, 0x51, 0x0a, 0x09, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x00, 0x12, 0x16, 0x0a,
+	0x12, 0x47, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x4f, 0x46, 0x46, 0x49,
+	0x43, 0x45, 0x52, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x52,
+	0x41, 0x4e, 0x4b, 0x5f, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x02, 0x2a, 0x54, 0x0a, 0x07,
+	0x54, 0x65, 0x72, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x45, 0x52, 0x52, 0x41,
+	0x49, 0x4e, 0x5f, 0x44, 0x49, 0x52, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x45, 0x52,
+	0x52, 0x41, 0x49, 0x4e, 0x5f, 0x52, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54,
+	0x45, 0x52, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x48, 0x49, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x13, 0x0a,
+	0x0f, 0x54, 0x45, 0x52, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x52, 0x59, 0x53, 0x54, 0x41, 0x4c,
+	0x10, 0x03, 0x32, 0x78, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x69,
+	0x67, 0x6e, 0x55, 0x70, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x12, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xdb, 0x07, 0x0a,
+	0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x34, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
+	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x4a,
+	0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71,
+	0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x13,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08, 0x4b, 0x69,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b,
+	0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x09,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x13,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x34, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79,
+	0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x3c, 0x0a, 0x0c, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x34, 0x0a,
+	0x08, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65,
+	0x73, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b,
+	0x41, 0x63, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x6b, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x32, 0xf9, 0x05, 0x0a, 0x04, 0x43,
+	0x68, 0x61, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x73, 0x22, 0x00, 0x32, 0x83, 0x05, 0x0a, 0x06, 0x53, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x12, 0x4f, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x22, 0x00, 0x12, 0x55, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x14, 0x44, 0x65, 0x63,
+	0x6c, 0x69, 0x6e, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e,
+	0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e,
+	0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x22, 0x00, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3d,
+	0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x22, 0x00, 0x32, 0xb6, 0x04, 0x0a,
+	0x05, 0x50, 0x61, 0x72, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x61, 0x72, 0x74, 0x79, 0x12, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x52, 0x0a, 0x12, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
+	0x63, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x55, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x00, 0x30, 0x01, 0x32, 0x86, 0x08, 0x0a, 0x05, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12,
+	0x3d, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x73, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x17,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x44, 0x65, 0x63, 0x6c,
+	0x69, 0x6e, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0c,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c,
+	0x64, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x54, 0x6f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x5e,
+	0x0a, 0x16, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x61,
+	0x0a, 0x17, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x75, 0x69, 0x6c,
+	0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x49, 0x0a,
+	0x0f, 0x4b, 0x69, 0x63, 0x6b, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c,
+	0x64, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0c, 0x53, 0x65,
+	0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x6f, 0x74, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x6f, 0x74, 0x64, 0x52,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x4d, 0x6f, 0x74, 0x64, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0c,
+	0x44, 0x69, 0x73, 0x62, 0x61, 0x6e, 0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x62, 0x61, 0x6e, 0x64, 0x47, 0x75, 0x69, 0x6c,
+	0x64, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73,
+	0x62, 0x61, 0x6e, 0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x22, 0x00, 0x32, 0x78,
+	0x0a, 0x05, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x70, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x64, 0x72, 0x70, 0x6c, 0x2f, 0x67, 0x72, 0x61,
+	0x6e, 0x6e, 0x79, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_granny_proto_rawDescOnce sync.Once
+	file_proto_granny_proto_rawDescData = file_proto_granny_proto_rawDesc
+)
+
+func file_proto_granny_proto_rawDescGZIP() []byte {
+	file_proto_granny_proto_rawDescOnce.Do(func() {
+		file_proto_granny_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_granny_proto_rawDescData)
+	})
+	return file_proto_granny_proto_rawDescData
+}
+
+var file_proto_granny_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_granny_proto_msgTypes = make([]protoimpl.MessageInfo, 141)
+var file_proto_granny_proto_goTypes = []interface{}{
+	(RoomState)(0),                     // 0: proto.RoomState
+	(GuildRank)(0),                     // 1: proto.GuildRank
+	(Terrain)(0),                       // 2: proto.Terrain
+	(*SignUpRequest)(nil),              // 3: proto.SignUpRequest
+	(*SignUpResponse)(nil),             // 4: proto.SignUpResponse
+	(*SignInRequest)(nil),              // 5: proto.SignInRequest
+	(*SignInResponse)(nil),             // 6: proto.SignInResponse
+	(*GetRoomRequest)(nil),             // 7: proto.GetRoomRequest
+	(*GetRoomResponse)(nil),            // 8: proto.GetRoomResponse
+	(*User)(nil),                       // 9: proto.User
+	(*JoinRoomReq)(nil),                // 10: proto.JoinRoomReq
+	(*JoinRoomRes)(nil),                // 11: proto.JoinRoomRes
+	(*UserJoinedReq)(nil),              // 12: proto.UserJoinedReq
+	(*RoomInfo)(nil),                   // 13: proto.RoomInfo
+	(*CreateRoomReq)(nil),              // 14: proto.CreateRoomReq
+	(*CreateRoomRes)(nil),              // 15: proto.CreateRoomRes
+	(*ListRoomsReq)(nil),               // 16: proto.ListRoomsReq
+	(*ListRoomsRes)(nil),               // 17: proto.ListRoomsRes
+	(*LeaveRoomReq)(nil),               // 18: proto.LeaveRoomReq
+	(*LeaveRoomRes)(nil),               // 19: proto.LeaveRoomRes
+	(*KickUserReq)(nil),                // 20: proto.KickUserReq
+	(*KickUserRes)(nil),                // 21: proto.KickUserRes
+	(*UpdateRoomReq)(nil),              // 22: proto.UpdateRoomReq
+	(*UpdateRoomRes)(nil),              // 23: proto.UpdateRoomRes
+	(*StartGameReq)(nil),               // 24: proto.StartGameReq
+	(*StartGameRes)(nil),               // 25: proto.StartGameRes
+	(*RoomEventsReq)(nil),              // 26: proto.RoomEventsReq
+	(*RoomEvent)(nil),                  // 27: proto.RoomEvent
+	(*GameStarted)(nil),                // 28: proto.GameStarted
+	(*Vec2)(nil),                       // 29: proto.Vec2
+	(*EntityState)(nil),                // 30: proto.EntityState
+	(*GameSnapshot)(nil),               // 31: proto.GameSnapshot
+	(*SetReadyReq)(nil),                // 32: proto.SetReadyReq
+	(*SetReadyRes)(nil),                // 33: proto.SetReadyRes
+	(*ReadyChanged)(nil),               // 34: proto.ReadyChanged
+	(*CountdownStarted)(nil),           // 35: proto.CountdownStarted
+	(*CountdownCancelled)(nil),         // 36: proto.CountdownCancelled
+	(*RoomClosed)(nil),                 // 37: proto.RoomClosed
+	(*ResumeRoomReq)(nil),              // 38: proto.ResumeRoomReq
+	(*SpectateRoomReq)(nil),            // 39: proto.SpectateRoomReq
+	(*RoomSnapshot)(nil),               // 40: proto.RoomSnapshot
+	(*RoomMember)(nil),                 // 41: proto.RoomMember
+	(*SendChatReq)(nil),                // 42: proto.SendChatReq
+	(*SendChatRes)(nil),                // 43: proto.SendChatRes
+	(*SendInputReq)(nil),               // 44: proto.SendInputReq
+	(*SendInputRes)(nil),               // 45: proto.SendInputRes
+	(*AckSnapshotReq)(nil),             // 46: proto.AckSnapshotReq
+	(*AckSnapshotRes)(nil),             // 47: proto.AckSnapshotRes
+	(*GetNetStatsReq)(nil),             // 48: proto.GetNetStatsReq
+	(*GetNetStatsRes)(nil),             // 49: proto.GetNetStatsRes
+	(*ClientNetStats)(nil),             // 50: proto.ClientNetStats
+	(*ChatMessage)(nil),                // 51: proto.ChatMessage
+	(*ChannelInfo)(nil),                // 52: proto.ChannelInfo
+	(*ChannelMessage)(nil),             // 53: proto.ChannelMessage
+	(*ListChannelsReq)(nil),            // 54: proto.ListChannelsReq
+	(*ListChannelsRes)(nil),            // 55: proto.ListChannelsRes
+	(*JoinChannelReq)(nil),             // 56: proto.JoinChannelReq
+	(*JoinChannelRes)(nil),             // 57: proto.JoinChannelRes
+	(*LeaveChannelReq)(nil),            // 58: proto.LeaveChannelReq
+	(*LeaveChannelRes)(nil),            // 59: proto.LeaveChannelRes
+	(*SendChannelMessageReq)(nil),      // 60: proto.SendChannelMessageReq
+	(*SendChannelMessageRes)(nil),      // 61: proto.SendChannelMessageRes
+	(*GetChannelHistoryReq)(nil),       // 62: proto.GetChannelHistoryReq
+	(*GetChannelHistoryRes)(nil),       // 63: proto.GetChannelHistoryRes
+	(*ChannelMessagesReq)(nil),         // 64: proto.ChannelMessagesReq
+	(*DirectMessage)(nil),              // 65: proto.DirectMessage
+	(*SendDirectMessageReq)(nil),       // 66: proto.SendDirectMessageReq
+	(*SendDirectMessageRes)(nil),       // 67: proto.SendDirectMessageRes
+	(*DirectMessagesReq)(nil),          // 68: proto.DirectMessagesReq
+	(*UnreadCount)(nil),                // 69: proto.UnreadCount
+	(*GetUnreadCountsReq)(nil),         // 70: proto.GetUnreadCountsReq
+	(*GetUnreadCountsRes)(nil),         // 71: proto.GetUnreadCountsRes
+	(*MarkDirectMessagesReadReq)(nil),  // 72: proto.MarkDirectMessagesReadReq
+	(*MarkDirectMessagesReadRes)(nil),  // 73: proto.MarkDirectMessagesReadRes
+	(*Friend)(nil),                     // 74: proto.Friend
+	(*SendFriendRequestReq)(nil),       // 75: proto.SendFriendRequestReq
+	(*SendFriendRequestRes)(nil),       // 76: proto.SendFriendRequestRes
+	(*AcceptFriendRequestReq)(nil),     // 77: proto.AcceptFriendRequestReq
+	(*AcceptFriendRequestRes)(nil),     // 78: proto.AcceptFriendRequestRes
+	(*DeclineFriendRequestReq)(nil),    // 79: proto.DeclineFriendRequestReq
+	(*DeclineFriendRequestRes)(nil),    // 80: proto.DeclineFriendRequestRes
+	(*RemoveFriendReq)(nil),            // 81: proto.RemoveFriendReq
+	(*RemoveFriendRes)(nil),            // 82: proto.RemoveFriendRes
+	(*ListFriendsReq)(nil),             // 83: proto.ListFriendsReq
+	(*ListFriendsRes)(nil),             // 84: proto.ListFriendsRes
+	(*PresenceUpdatesReq)(nil),         // 85: proto.PresenceUpdatesReq
+	(*BlockUserReq)(nil),               // 86: proto.BlockUserReq
+	(*BlockUserRes)(nil),               // 87: proto.BlockUserRes
+	(*UnblockUserReq)(nil),             // 88: proto.UnblockUserReq
+	(*UnblockUserRes)(nil),             // 89: proto.UnblockUserRes
+	(*ListBlockedReq)(nil),             // 90: proto.ListBlockedReq
+	(*ListBlockedRes)(nil),             // 91: proto.ListBlockedRes
+	(*PartyInfo)(nil),                  // 92: proto.PartyInfo
+	(*PartyEvent)(nil),                 // 93: proto.PartyEvent
+	(*CreatePartyReq)(nil),             // 94: proto.CreatePartyReq
+	(*CreatePartyRes)(nil),             // 95: proto.CreatePartyRes
+	(*GetPartyReq)(nil),                // 96: proto.GetPartyReq
+	(*GetPartyRes)(nil),                // 97: proto.GetPartyRes
+	(*InviteToPartyReq)(nil),           // 98: proto.InviteToPartyReq
+	(*InviteToPartyRes)(nil),           // 99: proto.InviteToPartyRes
+	(*AcceptPartyInviteReq)(nil),       // 100: proto.AcceptPartyInviteReq
+	(*AcceptPartyInviteRes)(nil),       // 101: proto.AcceptPartyInviteRes
+	(*DeclinePartyInviteReq)(nil),      // 102: proto.DeclinePartyInviteReq
+	(*DeclinePartyInviteRes)(nil),      // 103: proto.DeclinePartyInviteRes
+	(*LeavePartyReq)(nil),              // 104: proto.LeavePartyReq
+	(*LeavePartyRes)(nil),              // 105: proto.LeavePartyRes
+	(*TransferPartyLeaderReq)(nil),     // 106: proto.TransferPartyLeaderReq
+	(*TransferPartyLeaderRes)(nil),     // 107: proto.TransferPartyLeaderRes
+	(*PartyEventsReq)(nil),             // 108: proto.PartyEventsReq
+	(*GuildInfo)(nil),                  // 109: proto.GuildInfo
+	(*GuildMember)(nil),                // 110: proto.GuildMember
+	(*CreateGuildReq)(nil),             // 111: proto.CreateGuildReq
+	(*CreateGuildRes)(nil),             // 112: proto.CreateGuildRes
+	(*GetGuildReq)(nil),                // 113: proto.GetGuildReq
+	(*GetGuildRes)(nil),                // 114: proto.GetGuildRes
+	(*InviteToGuildReq)(nil),           // 115: proto.InviteToGuildReq
+	(*InviteToGuildRes)(nil),           // 116: proto.InviteToGuildRes
+	(*ListGuildInvitesReq)(nil),        // 117: proto.ListGuildInvitesReq
+	(*ListGuildInvitesRes)(nil),        // 118: proto.ListGuildInvitesRes
+	(*AcceptGuildInviteReq)(nil),       // 119: proto.AcceptGuildInviteReq
+	(*AcceptGuildInviteRes)(nil),       // 120: proto.AcceptGuildInviteRes
+	(*DeclineGuildInviteReq)(nil),      // 121: proto.DeclineGuildInviteReq
+	(*DeclineGuildInviteRes)(nil),      // 122: proto.DeclineGuildInviteRes
+	(*ApplyToGuildReq)(nil),            // 123: proto.ApplyToGuildReq
+	(*ApplyToGuildRes)(nil),            // 124: proto.ApplyToGuildRes
+	(*AcceptGuildApplicationReq)(nil),  // 125: proto.AcceptGuildApplicationReq
+	(*AcceptGuildApplicationRes)(nil),  // 126: proto.AcceptGuildApplicationRes
+	(*DeclineGuildApplicationReq)(nil), // 127: proto.DeclineGuildApplicationReq
+	(*DeclineGuildApplicationRes)(nil), // 128: proto.DeclineGuildApplicationRes
+	(*LeaveGuildReq)(nil),              // 129: proto.LeaveGuildReq
+	(*LeaveGuildRes)(nil),              // 130: proto.LeaveGuildRes
+	(*KickGuildMemberReq)(nil),         // 131: proto.KickGuildMemberReq
+	(*KickGuildMemberRes)(nil),         // 132: proto.KickGuildMemberRes
+	(*SetGuildRankReq)(nil),            // 133: proto.SetGuildRankReq
+	(*SetGuildRankRes)(nil),            // 134: proto.SetGuildRankRes
+	(*SetGuildMotdReq)(nil),            // 135: proto.SetGuildMotdReq
+	(*SetGuildMotdRes)(nil),            // 136: proto.SetGuildMotdRes
+	(*DisbandGuildReq)(nil),            // 137: proto.DisbandGuildReq
+	(*DisbandGuildRes)(nil),            // 138: proto.DisbandGuildRes
+	(*GetMapInfoReq)(nil),              // 139: proto.GetMapInfoReq
+	(*GetMapInfoRes)(nil),              // 140: proto.GetMapInfoRes
+	(*GetChunkReq)(nil),                // 141: proto.GetChunkReq
+	(*MapChunk)(nil),                   // 142: proto.MapChunk
+	nil,                                // 143: proto.GetRoomResponse.UsersEntry
+}
+var file_proto_granny_proto_depIdxs = []int32{
+	143, // 0: proto.GetRoomResponse.users:type_name -> proto.GetRoomResponse.UsersEntry
+	13,  // 1: proto.GetRoomResponse.room:type_name -> proto.RoomInfo
+	51,  // 2: proto.GetRoomResponse.chat_history:type_name -> proto.ChatMessage
+	51,  // 3: proto.JoinRoomRes.chat_history:type_name -> proto.ChatMessage
+	0,   // 4: proto.RoomInfo.state:type_name -> proto.RoomState
+	9,   // 5: proto.RoomInfo.host:type_name -> proto.User
+	13,  // 6: proto.CreateRoomRes.room:type_name -> proto.RoomInfo
+	0,   // 7: proto.ListRoomsReq.states:type_name -> proto.RoomState
+	13,  // 8: proto.ListRoomsRes.rooms:type_name -> proto.RoomInfo
+	13,  // 9: proto.UpdateRoomRes.room:type_name -> proto.RoomInfo
+	9,   // 10: proto.RoomEvent.user_joined:type_name -> proto.User
+	9,   // 11: proto.RoomEvent.user_left:type_name -> proto.User
+	9,   // 12: proto.RoomEvent.user_kicked:type_name -> proto.User
+	9,   // 13: proto.RoomEvent.host_changed:type_name -> proto.User
+	13,  // 14: proto.RoomEvent.settings_changed:type_name -> proto.RoomInfo
+	28,  // 15: proto.RoomEvent.game_started:type_name -> proto.GameStarted
+	34,  // 16: proto.RoomEvent.ready_changed:type_name -> proto.ReadyChanged
+	35,  // 17: proto.RoomEvent.countdown_started:type_name -> proto.CountdownStarted
+	36,  // 18: proto.RoomEvent.countdown_cancelled:type_name -> proto.CountdownCancelled
+	9,   // 19: proto.RoomEvent.user_disconnected:type_name -> proto.User
+	9,   // 20: proto.RoomEvent.user_reconnected:type_name -> proto.User
+	37,  // 21: proto.RoomEvent.room_closed:type_name -> proto.RoomClosed
+	51,  // 22: proto.RoomEvent.chat_message:type_name -> proto.ChatMessage
+	31,  // 23: proto.RoomEvent.snapshot:type_name -> proto.GameSnapshot
+	30,  // 24: proto.GameSnapshot.entities:type_name -> proto.EntityState
+	9,   // 25: proto.ReadyChanged.user:type_name -> proto.User
+	13,  // 26: proto.RoomSnapshot.room:type_name -> proto.RoomInfo
+	41,  // 27: proto.RoomSnapshot.members:type_name -> proto.RoomMember
+	51,  // 28: proto.RoomSnapshot.chat_history:type_name -> proto.ChatMessage
+	31,  // 29: proto.RoomSnapshot.game:type_name -> proto.GameSnapshot
+	9,   // 30: proto.RoomMember.user:type_name -> proto.User
+	29,  // 31: proto.SendInputReq.direction:type_name -> proto.Vec2
+	29,  // 32: proto.SendInputReq.target:type_name -> proto.Vec2
+	50,  // 33: proto.GetNetStatsRes.clients:type_name -> proto.ClientNetStats
+	9,   // 34: proto.ClientNetStats.user:type_name -> proto.User
+	9,   // 35: proto.ChatMessage.sender:type_name -> proto.User
+	9,   // 36: proto.ChannelMessage.sender:type_name -> proto.User
+	52,  // 37: proto.ListChannelsRes.channels:type_name -> proto.ChannelInfo
+	53,  // 38: proto.SendChannelMessageRes.message:type_name -> proto.ChannelMessage
+	53,  // 39: proto.GetChannelHistoryRes.messages:type_name -> proto.ChannelMessage
+	9,   // 40: proto.DirectMessage.sender:type_name -> proto.User
+	9,   // 41: proto.DirectMessage.recipient:type_name -> proto.User
+	65,  // 42: proto.SendDirectMessageRes.message:type_name -> proto.DirectMessage
+	9,   // 43: proto.UnreadCount.sender:type_name -> proto.User
+	69,  // 44: proto.GetUnreadCountsRes.counts:type_name -> proto.UnreadCount
+	9,   // 45: proto.Friend.user:type_name -> proto.User
+	74,  // 46: proto.ListFriendsRes.friends:type_name -> proto.Friend
+	9,   // 47: proto.ListFriendsRes.incoming_requests:type_name -> proto.User
+	9,   // 48: proto.ListFriendsRes.outgoing_requests:type_name -> proto.User
+	9,   // 49: proto.ListBlockedRes.users:type_name -> proto.User
+	9,   // 50: proto.PartyInfo.leader:type_name -> proto.User
+	9,   // 51: proto.PartyInfo.members:type_name -> proto.User
+	9,   // 52: proto.PartyInfo.invites:type_name -> proto.User
+	92,  // 53: proto.PartyEvent.party:type_name -> proto.PartyInfo
+	9,   // 54: proto.PartyEvent.invited:type_name -> proto.User
+	9,   // 55: proto.PartyEvent.member_joined:type_name -> proto.User
+	9,   // 56: proto.PartyEvent.member_left:type_name -> proto.User
+	9,   // 57: proto.PartyEvent.leader_changed:type_name -> proto.User
+	9,   // 58: proto.PartyEvent.invite_declined:type_name -> proto.User
+	92,  // 59: proto.CreatePartyRes.party:type_name -> proto.PartyInfo
+	92,  // 60: proto.GetPartyRes.party:type_name -> proto.PartyInfo
+	92,  // 61: proto.AcceptPartyInviteRes.party:type_name -> proto.PartyInfo
+	9,   // 62: proto.GuildMember.user:type_name -> proto.User
+	1,   // 63: proto.GuildMember.rank:type_name -> proto.GuildRank
+	109, // 64: proto.CreateGuildRes.guild:type_name -> proto.GuildInfo
+	109, // 65: proto.GetGuildRes.guild:type_name -> proto.GuildInfo
+	110, // 66: proto.GetGuildRes.members:type_name -> proto.GuildMember
+	9,   // 67: proto.GetGuildRes.applications:type_name -> proto.User
+	9,   // 68: proto.GetGuildRes.invites:type_name -> proto.User
+	109, // 69: proto.ListGuildInvitesRes.guilds:type_name -> proto.GuildInfo
+	109, // 70: proto.AcceptGuildInviteRes.guild:type_name -> proto.GuildInfo
+	1,   // 71: proto.SetGuildRankReq.rank:type_name -> proto.GuildRank
+	9,   // 72: proto.GetRoomResponse.UsersEntry.value:type_name -> proto.User
+	3,   // 73: proto.Auth.SignUp:input_type -> proto.SignUpRequest
+	5,   // 74: proto.Auth.SignIn:input_type -> proto.SignInRequest
+	7,   // 75: proto.Room.GetRoom:input_type -> proto.GetRoomRequest
+	10,  // 76: proto.Room.JoinRoom:input_type -> proto.JoinRoomReq
+	12,  // 77: proto.Room.UserJoined:input_type -> proto.UserJoinedReq
+	14,  // 78: proto.Room.CreateRoom:input_type -> proto.CreateRoomReq
+	16,  // 79: proto.Room.ListRooms:input_type -> proto.ListRoomsReq
+	18,  // 80: proto.Room.LeaveRoom:input_type -> proto.LeaveRoomReq
+	20,  // 81: proto.Room.KickUser:input_type -> proto.KickUserReq
+	22,  // 82: proto.Room.UpdateRoom:input_type -> proto.UpdateRoomReq
+	24,  // 83: proto.Room.StartGame:input_type -> proto.StartGameReq
+	26,  // 84: proto.Room.RoomEvents:input_type -> proto.RoomEventsReq
+	32,  // 85: proto.Room.SetReady:input_type -> proto.SetReadyReq
+	38,  // 86: proto.Room.ResumeRoom:input_type -> proto.ResumeRoomReq
+	39,  // 87: proto.Room.SpectateRoom:input_type -> proto.SpectateRoomReq
+	42,  // 88: proto.Room.SendChat:input_type -> proto.SendChatReq
+	44,  // 89: proto.Room.SendInput:input_type -> proto.SendInputReq
+	46,  // 90: proto.Room.AckSnapshot:input_type -> proto.AckSnapshotReq
+	48,  // 91: proto.Room.GetNetStats:input_type -> proto.GetNetStatsReq
+	54,  // 92: proto.Chat.ListChannels:input_type -> proto.ListChannelsReq
+	56,  // 93: proto.Chat.JoinChannel:input_type -> proto.JoinChannelReq
+	58,  // 94: proto.Chat.LeaveChannel:input_type -> proto.LeaveChannelReq
+	60,  // 95: proto.Chat.SendChannelMessage:input_type -> proto.SendChannelMessageReq
+	62,  // 96: proto.Chat.GetChannelHistory:input_type -> proto.GetChannelHistoryReq
+	64,  // 97: proto.Chat.ChannelMessages:input_type -> proto.ChannelMessagesReq
+	66,  // 98: proto.Chat.SendDirectMessage:input_type -> proto.SendDirectMessageReq
+	68,  // 99: proto.Chat.DirectMessages:input_type -> proto.DirectMessagesReq
+	70,  // 100: proto.Chat.GetUnreadCounts:input_type -> proto.GetUnreadCountsReq
+	72,  // 101: proto.Chat.MarkDirectMessagesRead:input_type -> proto.MarkDirectMessagesReadReq
+	75,  // 102: proto.Social.SendFriendRequest:input_type -> proto.SendFriendRequestReq
+	77,  // 103: proto.Social.AcceptFriendRequest:input_type -> proto.AcceptFriendRequestReq
+	79,  // 104: proto.Social.DeclineFriendRequest:input_type -> proto.DeclineFriendRequestReq
+	81,  // 105: proto.Social.RemoveFriend:input_type -> proto.RemoveFriendReq
+	83,  // 106: proto.Social.ListFriends:input_type -> proto.ListFriendsReq
+	85,  // 107: proto.Social.PresenceUpdates:input_type -> proto.PresenceUpdatesReq
+	86,  // 108: proto.Social.BlockUser:input_type -> proto.BlockUserReq
+	88,  // 109: proto.Social.UnblockUser:input_type -> proto.UnblockUserReq
+	90,  // 110: proto.Social.ListBlocked:input_type -> proto.ListBlockedReq
+	94,  // 111: proto.Party.CreateParty:input_type -> proto.CreatePartyReq
+	96,  // 112: proto.Party.GetParty:input_type -> proto.GetPartyReq
+	98,  // 113: proto.Party.InviteToParty:input_type -> proto.InviteToPartyReq
+	100, // 114: proto.Party.AcceptPartyInvite:input_type -> proto.AcceptPartyInviteReq
+	102, // 115: proto.Party.DeclinePartyInvite:input_type -> proto.DeclinePartyInviteReq
+	104, // 116: proto.Party.LeaveParty:input_type -> proto.LeavePartyReq
+	106, // 117: proto.Party.TransferPartyLeader:input_type -> proto.TransferPartyLeaderReq
+	108, // 118: proto.Party.PartyEvents:input_type -> proto.PartyEventsReq
+	111, // 119: proto.Guild.CreateGuild:input_type -> proto.CreateGuildReq
+	113, // 120: proto.Guild.GetGuild:input_type -> proto.GetGuildReq
+	115, // 121: proto.Guild.InviteToGuild:input_type -> proto.InviteToGuildReq
+	117, // 122: proto.Guild.ListGuildInvites:input_type -> proto.ListGuildInvitesReq
+	119, // 123: proto.Guild.AcceptGuildInvite:input_type -> proto.AcceptGuildInviteReq
+	121, // 124: proto.Guild.DeclineGuildInvite:input_type -> proto.DeclineGuildInviteReq
+	123, // 125: proto.Guild.ApplyToGuild:input_type -> proto.ApplyToGuildReq
+	125, // 126: proto.Guild.AcceptGuildApplication:input_type -> proto.AcceptGuildApplicationReq
+	127, // 127: proto.Guild.DeclineGuildApplication:input_type -> proto.DeclineGuildApplicationReq
+	129, // 128: proto.Guild.LeaveGuild:input_type -> proto.LeaveGuildReq
+	131, // 129: proto.Guild.KickGuildMember:input_type -> proto.KickGuildMemberReq
+	133, // 130: proto.Guild.SetGuildRank:input_type -> proto.SetGuildRankReq
+	135, // 131: proto.Guild.SetGuildMotd:input_type -> proto.SetGuildMotdReq
+	137, // 132: proto.Guild.DisbandGuild:input_type -> proto.DisbandGuildReq
+	139, // 133: proto.World.GetMapInfo:input_type -> proto.GetMapInfoReq
+	141, // 134: proto.World.GetChunk:input_type -> proto.GetChunkReq
+	4,   // 135: proto.Auth.SignUp:output_type -> proto.SignUpResponse
+	6,   // 136: proto.Auth.SignIn:output_type -> proto.SignInResponse
+	8,   // 137: proto.Room.GetRoom:output_type -> proto.GetRoomResponse
+	11,  // 138: proto.Room.JoinRoom:output_type -> proto.JoinRoomRes
+	9,   // 139: proto.Room.UserJoined:output_type -> proto.User
+	15,  // 140: proto.Room.CreateRoom:output_type -> proto.CreateRoomRes
+	17,  // 141: proto.Room.ListRooms:output_type -> proto.ListRoomsRes
+	19,  // 142: proto.Room.LeaveRoom:output_type -> proto.LeaveRoomRes
+	21,  // 143: proto.Room.KickUser:output_type -> proto.KickUserRes
+	23,  // 144: proto.Room.UpdateRoom:output_type -> proto.UpdateRoomRes
+	25,  // 145: proto.Room.StartGame:output_type -> proto.StartGameRes
+	27,  // 146: proto.Room.RoomEvents:output_type -> proto.RoomEvent
+	33,  // 147: proto.Room.SetReady:output_type -> proto.SetReadyRes
+	27,  // 148: proto.Room.ResumeRoom:output_type -> proto.RoomEvent
+	27,  // 149: proto.Room.SpectateRoom:output_type -> proto.RoomEvent
+	43,  // 150: proto.Room.SendChat:output_type -> proto.SendChatRes
+	45,  // 151: proto.Room.SendInput:output_type -> proto.SendInputRes
+	47,  // 152: proto.Room.AckSnapshot:output_type -> proto.AckSnapshotRes
+	49,  // 153: proto.Room.GetNetStats:output_type -> proto.GetNetStatsRes
+	55,  // 154: proto.Chat.ListChannels:output_type -> proto.ListChannelsRes
+	57,  // 155: proto.Chat.JoinChannel:output_type -> proto.JoinChannelRes
+	59,  // 156: proto.Chat.LeaveChannel:output_type -> proto.LeaveChannelRes
+	61,  // 157: proto.Chat.SendChannelMessage:output_type -> proto.SendChannelMessageRes
+	63,  // 158: proto.Chat.GetChannelHistory:output_type -> proto.GetChannelHistoryRes
+	53,  // 159: proto.Chat.ChannelMessages:output_type -> proto.ChannelMessage
+	67,  // 160: proto.Chat.SendDirectMessage:output_type -> proto.SendDirectMessageRes
+	65,  // 161: proto.Chat.DirectMessages:output_type -> proto.DirectMessage
+	71,  // 162: proto.Chat.GetUnreadCounts:output_type -> proto.GetUnreadCountsRes
+	73,  // 163: proto.Chat.MarkDirectMessagesRead:output_type -> proto.MarkDirectMessagesReadRes
+	76,  // 164: proto.Social.SendFriendRequest:output_type -> proto.SendFriendRequestRes
+	78,  // 165: proto.Social.AcceptFriendRequest:output_type -> proto.AcceptFriendRequestRes
+	80,  // 166: proto.Social.DeclineFriendRequest:output_type -> proto.DeclineFriendRequestRes
+	82,  // 167: proto.Social.RemoveFriend:output_type -> proto.RemoveFriendRes
+	84,  // 168: proto.Social.ListFriends:output_type -> proto.ListFriendsRes
+	74,  // 169: proto.Social.PresenceUpdates:output_type -> proto.Friend
+	87,  // 170: proto.Social.BlockUser:output_type -> proto.BlockUserRes
+	89,  // 171: proto.Social.UnblockUser:output_type -> proto.UnblockUserRes
+	91,  // 172: proto.Social.ListBlocked:output_type -> proto.ListBlockedRes
+	95,  // 173: proto.Party.CreateParty:output_type -> proto.CreatePartyRes
+	97,  // 174: proto.Party.GetParty:output_type -> proto.GetPartyRes
+	99,  // 175: proto.Party.InviteToParty:output_type -> proto.InviteToPartyRes
+	101, // 176: proto.Party.AcceptPartyInvite:output_type -> proto.AcceptPartyInviteRes
+	103, // 177: proto.Party.DeclinePartyInvite:output_type -> proto.DeclinePartyInviteRes
+	105, // 178: proto.Party.LeaveParty:output_type -> proto.LeavePartyRes
+	107, // 179: proto.Party.TransferPartyLeader:output_type -> proto.TransferPartyLeaderRes
+	93,  // 180: proto.Party.PartyEvents:output_type -> proto.PartyEvent
+	112, // 181: proto.Guild.CreateGuild:output_type -> proto.CreateGuildRes
+	114, // 182: proto.Guild.GetGuild:output_type -> proto.GetGuildRes
+	116, // 183: proto.Guild.InviteToGuild:output_type -> proto.InviteToGuildRes
+	118, // 184: proto.Guild.ListGuildInvites:output_type -> proto.ListGuildInvitesRes
+	120, // 185: proto.Guild.AcceptGuildInvite:output_type -> proto.AcceptGuildInviteRes
+	122, // 186: proto.Guild.DeclineGuildInvite:output_type -> proto.DeclineGuildInviteRes
+	124, // 187: proto.Guild.ApplyToGuild:output_type -> proto.ApplyToGuildRes
+	126, // 188: proto.Guild.AcceptGuildApplication:output_type -> proto.AcceptGuildApplicationRes
+	128, // 189: proto.Guild.DeclineGuildApplication:output_type -> proto.DeclineGuildApplicationRes
+	130, // 190: proto.Guild.LeaveGuild:output_type -> proto.LeaveGuildRes
+	132, // 191: proto.Guild.KickGuildMember:output_type -> proto.KickGuildMemberRes
+	134, // 192: proto.Guild.SetGuildRank:output_type -> proto.SetGuildRankRes
+	136, // 193: proto.Guild.SetGuildMotd:output_type -> proto.SetGuildMotdRes
+	138, // 194: proto.Guild.DisbandGuild:output_type -> proto.DisbandGuildRes
+	140, // 195: proto.World.GetMapInfo:output_type -> proto.GetMapInfoRes
+	142, // 196: proto.World.GetChunk:output_type -> proto.MapChunk
+	135, // [135:197] is the sub-list for method output_type
+	73,  // [73:135] is the sub-list for method input_type
+	73,  // [73:73] is the sub-list for extension type_name
+	73,  // [73:73] is the sub-list for extension extendee
+	0,   // [0:73] is the sub-list for field type_name
+}
+
+func init() { file_proto_granny_proto_init() }
+func file_proto_granny_proto_init() {
+	if File_proto_granny_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_granny_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignUpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignUpResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRoomReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRoomRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserJoinedReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveRoomReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveRoomRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickUserRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoomReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoomRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartGameReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartGameRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomEventsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameStarted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vec2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReadyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReadyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadyChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountdownStarted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountdownCancelled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomClosed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeRoomReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpectateRoomReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendChatReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendChatRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendInputReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendInputRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckSnapshotReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckSnapshotRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetStatsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetStatsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientNetStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChannelsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChannelsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinChannelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinChannelRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveChannelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveChannelRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendChannelMessageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendChannelMessageRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelHistoryRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelMessagesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirectMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendDirectMessageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendDirectMessageRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirectMessagesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnreadCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnreadCountsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnreadCountsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkDirectMessagesReadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkDirectMessagesReadRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Friend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFriendRequestReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFriendRequestRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptFriendRequestReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptFriendRequestRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineFriendRequestReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineFriendRequestRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFriendReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFriendRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFriendsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFriendsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresenceUpdatesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockUserRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlockedReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlockedRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartyEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePartyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePartyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPartyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPartyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToPartyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToPartyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptPartyInviteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptPartyInviteRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclinePartyInviteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclinePartyInviteRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeavePartyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeavePartyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferPartyLeaderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferPartyLeaderRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartyEventsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuildInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuildMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGuildReq); i {
+			case 0:
+				return &v.state
 			case 1:
 				return &v.sizeCache
 			case 2:
@@ -643,8 +10384,8 @@ func file_proto_granny_proto_init() {
 				return nil
 			}
 		}
-		file_proto_granny_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInRequest); i {
+		file_proto_granny_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGuildRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -655,8 +10396,8 @@ func file_proto_granny_proto_init() {
 				return nil
 			}
 		}
-		file_proto_granny_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInResponse); i {
+		file_proto_granny_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGuildReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -667,8 +10408,8 @@ func file_proto_granny_proto_init() {
 				return nil
 			}
 		}
-		file_proto_granny_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoomRequest); i {
+		file_proto_granny_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGuildRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -679,8 +10420,8 @@ func file_proto_granny_proto_init() {
 				return nil
 			}
 		}
-		file_proto_granny_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoomResponse); i {
+		file_proto_granny_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToGuildReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -691,8 +10432,8 @@ func file_proto_granny_proto_init() {
 				return nil
 			}
 		}
-		file_proto_granny_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_proto_granny_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToGuildRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -703,8 +10444,8 @@ func file_proto_granny_proto_init() {
 				return nil
 			}
 		}
-		file_proto_granny_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinRoomReq); i {
+		file_proto_granny_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGuildInvitesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -715,8 +10456,8 @@ func file_proto_granny_proto_init() {
 				return nil
 			}
 		}
-		file_proto_granny_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinRoomRes); i {
+		file_proto_granny_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGuildInvitesRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -727,8 +10468,284 @@ func file_proto_granny_proto_init() {
 				return nil
 			}
 		}
-		file_proto_granny_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserJoinedReq); i {
+		file_proto_granny_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptGuildInviteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptGuildInviteRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineGuildInviteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineGuildInviteRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyToGuildReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyToGuildRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptGuildApplicationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptGuildApplicationRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineGuildApplicationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineGuildApplicationRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveGuildReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveGuildRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickGuildMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickGuildMemberRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGuildRankReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGuildRankRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGuildMotdReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGuildMotdRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisbandGuildReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisbandGuildRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMapInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMapInfoRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChunkReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_granny_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -740,18 +10757,76 @@ func file_proto_granny_proto_init() {
 			}
 		}
 	}
+	file_proto_granny_proto_msgTypes[24].OneofWrappers = []interface{}{
+		(*RoomEvent_UserJoined)(nil),
+		(*RoomEvent_UserLeft)(nil),
+		(*RoomEvent_UserKicked)(nil),
+		(*RoomEvent_HostChanged)(nil),
+		(*RoomEvent_SettingsChanged)(nil),
+		(*RoomEvent_GameStarted)(nil),
+		(*RoomEvent_ReadyChanged)(nil),
+		(*RoomEvent_CountdownStarted)(nil),
+		(*RoomEvent_CountdownCancelled)(nil),
+		(*RoomEvent_UserDisconnected)(nil),
+		(*RoomEvent_UserReconnected)(nil),
+		(*RoomEvent_RoomClosed)(nil),
+		(*RoomEvent_ChatMessage)(nil),
+		(*RoomEvent_Snapshot)(nil),
+	}
+	file_proto_granny_proto_msgTypes[41].OneofWrappers = []interface{}{
+		(*SendInputReq_Direction)(nil),
+		(*SendInputReq_Target)(nil),
+	}
+	file_proto_granny_proto_msgTypes[63].OneofWrappers = []interface{}{
+		(*SendDirectMessageReq_UserId)(nil),
+		(*SendDirectMessageReq_Name)(nil),
+	}
+	file_proto_granny_proto_msgTypes[72].OneofWrappers = []interface{}{
+		(*SendFriendRequestReq_UserId)(nil),
+		(*SendFriendRequestReq_Name)(nil),
+	}
+	file_proto_granny_proto_msgTypes[83].OneofWrappers = []interface{}{
+		(*BlockUserReq_UserId)(nil),
+		(*BlockUserReq_Name)(nil),
+	}
+	file_proto_granny_proto_msgTypes[90].OneofWrappers = []interface{}{
+		(*PartyEvent_Invited)(nil),
+		(*PartyEvent_MemberJoined)(nil),
+		(*PartyEvent_MemberLeft)(nil),
+		(*PartyEvent_LeaderChanged)(nil),
+		(*PartyEvent_InviteDeclined)(nil),
+		(*PartyEvent_JoinedRoom)(nil),
+		(*PartyEvent_Disbanded)(nil),
+	}
+	file_proto_granny_proto_msgTypes[95].OneofWrappers = []interface{}{
+		(*InviteToPartyReq_UserId)(nil),
+		(*InviteToPartyReq_Name)(nil),
+	}
+	file_proto_granny_proto_msgTypes[110].OneofWrappers = []interface{}{
+		(*GetGuildReq_Id)(nil),
+		(*GetGuildReq_Tag)(nil),
+	}
+	file_proto_granny_proto_msgTypes[112].OneofWrappers = []interface{}{
+		(*InviteToGuildReq_UserId)(nil),
+		(*InviteToGuildReq_Name)(nil),
+	}
+	file_proto_granny_proto_msgTypes[120].OneofWrappers = []interface{}{
+		(*ApplyToGuildReq_Id)(nil),
+		(*ApplyToGuildReq_Tag)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_granny_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   141,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   7,
 		},
 		GoTypes:           file_proto_granny_proto_goTypes,
 		DependencyIndexes: file_proto_granny_proto_depIdxs,
+		EnumInfos:         file_proto_granny_proto_enumTypes,
 		MessageInfos:      file_proto_granny_proto_msgTypes,
 	}.Build()
 	File_proto_granny_proto = out.File
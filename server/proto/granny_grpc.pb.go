@@ -143,6 +143,20 @@ type RoomClient interface {
 	GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*GetRoomResponse, error)
 	JoinRoom(ctx context.Context, in *JoinRoomReq, opts ...grpc.CallOption) (*JoinRoomRes, error)
 	UserJoined(ctx context.Context, in *UserJoinedReq, opts ...grpc.CallOption) (Room_UserJoinedClient, error)
+	CreateRoom(ctx context.Context, in *CreateRoomReq, opts ...grpc.CallOption) (*CreateRoomRes, error)
+	ListRooms(ctx context.Context, in *ListRoomsReq, opts ...grpc.CallOption) (*ListRoomsRes, error)
+	LeaveRoom(ctx context.Context, in *LeaveRoomReq, opts ...grpc.CallOption) (*LeaveRoomRes, error)
+	KickUser(ctx context.Context, in *KickUserReq, opts ...grpc.CallOption) (*KickUserRes, error)
+	UpdateRoom(ctx context.Context, in *UpdateRoomReq, opts ...grpc.CallOption) (*UpdateRoomRes, error)
+	StartGame(ctx context.Context, in *StartGameReq, opts ...grpc.CallOption) (*StartGameRes, error)
+	RoomEvents(ctx context.Context, in *RoomEventsReq, opts ...grpc.CallOption) (Room_RoomEventsClient, error)
+	SetReady(ctx context.Context, in *SetReadyReq, opts ...grpc.CallOption) (*SetReadyRes, error)
+	ResumeRoom(ctx context.Context, in *ResumeRoomReq, opts ...grpc.CallOption) (Room_ResumeRoomClient, error)
+	SpectateRoom(ctx context.Context, in *SpectateRoomReq, opts ...grpc.CallOption) (Room_SpectateRoomClient, error)
+	SendChat(ctx context.Context, in *SendChatReq, opts ...grpc.CallOption) (*SendChatRes, error)
+	SendInput(ctx context.Context, in *SendInputReq, opts ...grpc.CallOption) (*SendInputRes, error)
+	AckSnapshot(ctx context.Context, in *AckSnapshotReq, opts ...grpc.CallOption) (*AckSnapshotRes, error)
+	GetNetStats(ctx context.Context, in *GetNetStatsReq, opts ...grpc.CallOption) (*GetNetStatsRes, error)
 }
 
 type roomClient struct {
@@ -203,6 +217,201 @@ func (x *roomUserJoinedClient) Recv() (*User, error) {
 	return m, nil
 }
 
+func (c *roomClient) CreateRoom(ctx context.Context, in *CreateRoomReq, opts ...grpc.CallOption) (*CreateRoomRes, error) {
+	out := new(CreateRoomRes)
+	err := c.cc.Invoke(ctx, "/proto.Room/CreateRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) ListRooms(ctx context.Context, in *ListRoomsReq, opts ...grpc.CallOption) (*ListRoomsRes, error) {
+	out := new(ListRoomsRes)
+	err := c.cc.Invoke(ctx, "/proto.Room/ListRooms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) LeaveRoom(ctx context.Context, in *LeaveRoomReq, opts ...grpc.CallOption) (*LeaveRoomRes, error) {
+	out := new(LeaveRoomRes)
+	err := c.cc.Invoke(ctx, "/proto.Room/LeaveRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) KickUser(ctx context.Context, in *KickUserReq, opts ...grpc.CallOption) (*KickUserRes, error) {
+	out := new(KickUserRes)
+	err := c.cc.Invoke(ctx, "/proto.Room/KickUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) UpdateRoom(ctx context.Context, in *UpdateRoomReq, opts ...grpc.CallOption) (*UpdateRoomRes, error) {
+	out := new(UpdateRoomRes)
+	err := c.cc.Invoke(ctx, "/proto.Room/UpdateRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) StartGame(ctx context.Context, in *StartGameReq, opts ...grpc.CallOption) (*StartGameRes, error) {
+	out := new(StartGameRes)
+	err := c.cc.Invoke(ctx, "/proto.Room/StartGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) RoomEvents(ctx context.Context, in *RoomEventsReq, opts ...grpc.CallOption) (Room_RoomEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Room_ServiceDesc.Streams[1], "/proto.Room/RoomEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &roomRoomEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Room_RoomEventsClient interface {
+	Recv() (*RoomEvent, error)
+	grpc.ClientStream
+}
+
+type roomRoomEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *roomRoomEventsClient) Recv() (*RoomEvent, error) {
+	m := new(RoomEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *roomClient) SetReady(ctx context.Context, in *SetReadyReq, opts ...grpc.CallOption) (*SetReadyRes, error) {
+	out := new(SetReadyRes)
+	err := c.cc.Invoke(ctx, "/proto.Room/SetReady", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) ResumeRoom(ctx context.Context, in *ResumeRoomReq, opts ...grpc.CallOption) (Room_ResumeRoomClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Room_ServiceDesc.Streams[2], "/proto.Room/ResumeRoom", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &roomResumeRoomClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Room_ResumeRoomClient interface {
+	Recv() (*RoomEvent, error)
+	grpc.ClientStream
+}
+
+type roomResumeRoomClient struct {
+	grpc.ClientStream
+}
+
+func (x *roomResumeRoomClient) Recv() (*RoomEvent, error) {
+	m := new(RoomEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *roomClient) SpectateRoom(ctx context.Context, in *SpectateRoomReq, opts ...grpc.CallOption) (Room_SpectateRoomClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Room_ServiceDesc.Streams[3], "/proto.Room/SpectateRoom", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &roomSpectateRoomClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Room_SpectateRoomClient interface {
+	Recv() (*RoomEvent, error)
+	grpc.ClientStream
+}
+
+type roomSpectateRoomClient struct {
+	grpc.ClientStream
+}
+
+func (x *roomSpectateRoomClient) Recv() (*RoomEvent, error) {
+	m := new(RoomEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *roomClient) SendChat(ctx context.Context, in *SendChatReq, opts ...grpc.CallOption) (*SendChatRes, error) {
+	out := new(SendChatRes)
+	err := c.cc.Invoke(ctx, "/proto.Room/SendChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) SendInput(ctx context.Context, in *SendInputReq, opts ...grpc.CallOption) (*SendInputRes, error) {
+	out := new(SendInputRes)
+	err := c.cc.Invoke(ctx, "/proto.Room/SendInput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) AckSnapshot(ctx context.Context, in *AckSnapshotReq, opts ...grpc.CallOption) (*AckSnapshotRes, error) {
+	out := new(AckSnapshotRes)
+	err := c.cc.Invoke(ctx, "/proto.Room/AckSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomClient) GetNetStats(ctx context.Context, in *GetNetStatsReq, opts ...grpc.CallOption) (*GetNetStatsRes, error) {
+	out := new(GetNetStatsRes)
+	err := c.cc.Invoke(ctx, "/proto.Room/GetNetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoomServer is the server API for Room service.
 // All implementations must embed UnimplementedRoomServer
 // for forward compatibility
@@ -210,6 +419,20 @@ type RoomServer interface {
 	GetRoom(context.Context, *GetRoomRequest) (*GetRoomResponse, error)
 	JoinRoom(context.Context, *JoinRoomReq) (*JoinRoomRes, error)
 	UserJoined(*UserJoinedReq, Room_UserJoinedServer) error
+	CreateRoom(context.Context, *CreateRoomReq) (*CreateRoomRes, error)
+	ListRooms(context.Context, *ListRoomsReq) (*ListRoomsRes, error)
+	LeaveRoom(context.Context, *LeaveRoomReq) (*LeaveRoomRes, error)
+	KickUser(context.Context, *KickUserReq) (*KickUserRes, error)
+	UpdateRoom(context.Context, *UpdateRoomReq) (*UpdateRoomRes, error)
+	StartGame(context.Context, *StartGameReq) (*StartGameRes, error)
+	RoomEvents(*RoomEventsReq, Room_RoomEventsServer) error
+	SetReady(context.Context, *SetReadyReq) (*SetReadyRes, error)
+	ResumeRoom(*ResumeRoomReq, Room_ResumeRoomServer) error
+	SpectateRoom(*SpectateRoomReq, Room_SpectateRoomServer) error
+	SendChat(context.Context, *SendChatReq) (*SendChatRes, error)
+	SendInput(context.Context, *SendInputReq) (*SendInputRes, error)
+	AckSnapshot(context.Context, *AckSnapshotReq) (*AckSnapshotRes, error)
+	GetNetStats(context.Context, *GetNetStatsReq) (*GetNetStatsRes, error)
 	mustEmbedUnimplementedRoomServer()
 }
 
@@ -226,6 +449,48 @@ func (UnimplementedRoomServer) JoinRoom(context.Context, *JoinRoomReq) (*JoinRoo
 func (UnimplementedRoomServer) UserJoined(*UserJoinedReq, Room_UserJoinedServer) error {
 	return status.Errorf(codes.Unimplemented, "method UserJoined not implemented")
 }
+func (UnimplementedRoomServer) CreateRoom(context.Context, *CreateRoomReq) (*CreateRoomRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
+}
+func (UnimplementedRoomServer) ListRooms(context.Context, *ListRoomsReq) (*ListRoomsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRooms not implemented")
+}
+func (UnimplementedRoomServer) LeaveRoom(context.Context, *LeaveRoomReq) (*LeaveRoomRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveRoom not implemented")
+}
+func (UnimplementedRoomServer) KickUser(context.Context, *KickUserReq) (*KickUserRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickUser not implemented")
+}
+func (UnimplementedRoomServer) UpdateRoom(context.Context, *UpdateRoomReq) (*UpdateRoomRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoom not implemented")
+}
+func (UnimplementedRoomServer) StartGame(context.Context, *StartGameReq) (*StartGameRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGame not implemented")
+}
+func (UnimplementedRoomServer) RoomEvents(*RoomEventsReq, Room_RoomEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method RoomEvents not implemented")
+}
+func (UnimplementedRoomServer) SetReady(context.Context, *SetReadyReq) (*SetReadyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReady not implemented")
+}
+func (UnimplementedRoomServer) ResumeRoom(*ResumeRoomReq, Room_ResumeRoomServer) error {
+	return status.Errorf(codes.Unimplemented, "method ResumeRoom not implemented")
+}
+func (UnimplementedRoomServer) SpectateRoom(*SpectateRoomReq, Room_SpectateRoomServer) error {
+	return status.Errorf(codes.Unimplemented, "method SpectateRoom not implemented")
+}
+func (UnimplementedRoomServer) SendChat(context.Context, *SendChatReq) (*SendChatRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChat not implemented")
+}
+func (UnimplementedRoomServer) SendInput(context.Context, *SendInputReq) (*SendInputRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendInput not implemented")
+}
+func (UnimplementedRoomServer) AckSnapshot(context.Context, *AckSnapshotReq) (*AckSnapshotRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckSnapshot not implemented")
+}
+func (UnimplementedRoomServer) GetNetStats(context.Context, *GetNetStatsReq) (*GetNetStatsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetStats not implemented")
+}
 func (UnimplementedRoomServer) mustEmbedUnimplementedRoomServer() {}
 
 // UnsafeRoomServer may be embedded to opt out of forward compatibility for this service.
@@ -296,26 +561,2283 @@ func (x *roomUserJoinedServer) Send(m *User) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-// Room_ServiceDesc is the grpc.ServiceDesc for Room service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Room_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.Room",
-	HandlerType: (*RoomServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetRoom",
-			Handler:    _Room_GetRoom_Handler,
-		},
-		{
-			MethodName: "JoinRoom",
-			Handler:    _Room_JoinRoom_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "UserJoined",
-			Handler:       _Room_UserJoined_Handler,
+func _Room_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).CreateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/CreateRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).CreateRoom(ctx, req.(*CreateRoomReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_ListRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).ListRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/ListRooms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).ListRooms(ctx, req.(*ListRoomsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_LeaveRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveRoomReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).LeaveRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/LeaveRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).LeaveRoom(ctx, req.(*LeaveRoomReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_KickUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).KickUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/KickUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).KickUser(ctx, req.(*KickUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).UpdateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/UpdateRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).UpdateRoom(ctx, req.(*UpdateRoomReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).StartGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/StartGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).StartGame(ctx, req.(*StartGameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_RoomEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RoomEventsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RoomServer).RoomEvents(m, &roomRoomEventsServer{stream})
+}
+
+type Room_RoomEventsServer interface {
+	Send(*RoomEvent) error
+	grpc.ServerStream
+}
+
+type roomRoomEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *roomRoomEventsServer) Send(m *RoomEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Room_SetReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReadyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).SetReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/SetReady",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).SetReady(ctx, req.(*SetReadyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_ResumeRoom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResumeRoomReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RoomServer).ResumeRoom(m, &roomResumeRoomServer{stream})
+}
+
+type Room_ResumeRoomServer interface {
+	Send(*RoomEvent) error
+	grpc.ServerStream
+}
+
+type roomResumeRoomServer struct {
+	grpc.ServerStream
+}
+
+func (x *roomResumeRoomServer) Send(m *RoomEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Room_SpectateRoom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SpectateRoomReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RoomServer).SpectateRoom(m, &roomSpectateRoomServer{stream})
+}
+
+type Room_SpectateRoomServer interface {
+	Send(*RoomEvent) error
+	grpc.ServerStream
+}
+
+type roomSpectateRoomServer struct {
+	grpc.ServerStream
+}
+
+func (x *roomSpectateRoomServer) Send(m *RoomEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Room_SendChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChatReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).SendChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/SendChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).SendChat(ctx, req.(*SendChatReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_SendInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendInputReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).SendInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/SendInput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).SendInput(ctx, req.(*SendInputReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_AckSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckSnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).AckSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/AckSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).AckSnapshot(ctx, req.(*AckSnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Room_GetNetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServer).GetNetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Room/GetNetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServer).GetNetStats(ctx, req.(*GetNetStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Room_ServiceDesc is the grpc.ServiceDesc for Room service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Room_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Room",
+	HandlerType: (*RoomServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRoom",
+			Handler:    _Room_GetRoom_Handler,
+		},
+		{
+			MethodName: "JoinRoom",
+			Handler:    _Room_JoinRoom_Handler,
+		},
+		{
+			MethodName: "CreateRoom",
+			Handler:    _Room_CreateRoom_Handler,
+		},
+		{
+			MethodName: "ListRooms",
+			Handler:    _Room_ListRooms_Handler,
+		},
+		{
+			MethodName: "LeaveRoom",
+			Handler:    _Room_LeaveRoom_Handler,
+		},
+		{
+			MethodName: "KickUser",
+			Handler:    _Room_KickUser_Handler,
+		},
+		{
+			MethodName: "UpdateRoom",
+			Handler:    _Room_UpdateRoom_Handler,
+		},
+		{
+			MethodName: "StartGame",
+			Handler:    _Room_StartGame_Handler,
+		},
+		{
+			MethodName: "SetReady",
+			Handler:    _Room_SetReady_Handler,
+		},
+		{
+			MethodName: "SendChat",
+			Handler:    _Room_SendChat_Handler,
+		},
+		{
+			MethodName: "SendInput",
+			Handler:    _Room_SendInput_Handler,
+		},
+		{
+			MethodName: "AckSnapshot",
+			Handler:    _Room_AckSnapshot_Handler,
+		},
+		{
+			MethodName: "GetNetStats",
+			Handler:    _Room_GetNetStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UserJoined",
+			Handler:       _Room_UserJoined_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RoomEvents",
+			Handler:       _Room_RoomEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ResumeRoom",
+			Handler:       _Room_ResumeRoom_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SpectateRoom",
+			Handler:       _Room_SpectateRoom_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/granny.proto",
+}
+
+// ChatClient is the client API for Chat service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChatClient interface {
+	ListChannels(ctx context.Context, in *ListChannelsReq, opts ...grpc.CallOption) (*ListChannelsRes, error)
+	JoinChannel(ctx context.Context, in *JoinChannelReq, opts ...grpc.CallOption) (*JoinChannelRes, error)
+	LeaveChannel(ctx context.Context, in *LeaveChannelReq, opts ...grpc.CallOption) (*LeaveChannelRes, error)
+	SendChannelMessage(ctx context.Context, in *SendChannelMessageReq, opts ...grpc.CallOption) (*SendChannelMessageRes, error)
+	GetChannelHistory(ctx context.Context, in *GetChannelHistoryReq, opts ...grpc.CallOption) (*GetChannelHistoryRes, error)
+	ChannelMessages(ctx context.Context, in *ChannelMessagesReq, opts ...grpc.CallOption) (Chat_ChannelMessagesClient, error)
+	SendDirectMessage(ctx context.Context, in *SendDirectMessageReq, opts ...grpc.CallOption) (*SendDirectMessageRes, error)
+	DirectMessages(ctx context.Context, in *DirectMessagesReq, opts ...grpc.CallOption) (Chat_DirectMessagesClient, error)
+	GetUnreadCounts(ctx context.Context, in *GetUnreadCountsReq, opts ...grpc.CallOption) (*GetUnreadCountsRes, error)
+	MarkDirectMessagesRead(ctx context.Context, in *MarkDirectMessagesReadReq, opts ...grpc.CallOption) (*MarkDirectMessagesReadRes, error)
+}
+
+type chatClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChatClient(cc grpc.ClientConnInterface) ChatClient {
+	return &chatClient{cc}
+}
+
+func (c *chatClient) ListChannels(ctx context.Context, in *ListChannelsReq, opts ...grpc.CallOption) (*ListChannelsRes, error) {
+	out := new(ListChannelsRes)
+	err := c.cc.Invoke(ctx, "/proto.Chat/ListChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) JoinChannel(ctx context.Context, in *JoinChannelReq, opts ...grpc.CallOption) (*JoinChannelRes, error) {
+	out := new(JoinChannelRes)
+	err := c.cc.Invoke(ctx, "/proto.Chat/JoinChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) LeaveChannel(ctx context.Context, in *LeaveChannelReq, opts ...grpc.CallOption) (*LeaveChannelRes, error) {
+	out := new(LeaveChannelRes)
+	err := c.cc.Invoke(ctx, "/proto.Chat/LeaveChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SendChannelMessage(ctx context.Context, in *SendChannelMessageReq, opts ...grpc.CallOption) (*SendChannelMessageRes, error) {
+	out := new(SendChannelMessageRes)
+	err := c.cc.Invoke(ctx, "/proto.Chat/SendChannelMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetChannelHistory(ctx context.Context, in *GetChannelHistoryReq, opts ...grpc.CallOption) (*GetChannelHistoryRes, error) {
+	out := new(GetChannelHistoryRes)
+	err := c.cc.Invoke(ctx, "/proto.Chat/GetChannelHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ChannelMessages(ctx context.Context, in *ChannelMessagesReq, opts ...grpc.CallOption) (Chat_ChannelMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Chat_ServiceDesc.Streams[0], "/proto.Chat/ChannelMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chatChannelMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chat_ChannelMessagesClient interface {
+	Recv() (*ChannelMessage, error)
+	grpc.ClientStream
+}
+
+type chatChannelMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatChannelMessagesClient) Recv() (*ChannelMessage, error) {
+	m := new(ChannelMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chatClient) SendDirectMessage(ctx context.Context, in *SendDirectMessageReq, opts ...grpc.CallOption) (*SendDirectMessageRes, error) {
+	out := new(SendDirectMessageRes)
+	err := c.cc.Invoke(ctx, "/proto.Chat/SendDirectMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) DirectMessages(ctx context.Context, in *DirectMessagesReq, opts ...grpc.CallOption) (Chat_DirectMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Chat_ServiceDesc.Streams[1], "/proto.Chat/DirectMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chatDirectMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chat_DirectMessagesClient interface {
+	Recv() (*DirectMessage, error)
+	grpc.ClientStream
+}
+
+type chatDirectMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatDirectMessagesClient) Recv() (*DirectMessage, error) {
+	m := new(DirectMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chatClient) GetUnreadCounts(ctx context.Context, in *GetUnreadCountsReq, opts ...grpc.CallOption) (*GetUnreadCountsRes, error) {
+	out := new(GetUnreadCountsRes)
+	err := c.cc.Invoke(ctx, "/proto.Chat/GetUnreadCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) MarkDirectMessagesRead(ctx context.Context, in *MarkDirectMessagesReadReq, opts ...grpc.CallOption) (*MarkDirectMessagesReadRes, error) {
+	out := new(MarkDirectMessagesReadRes)
+	err := c.cc.Invoke(ctx, "/proto.Chat/MarkDirectMessagesRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChatServer is the server API for Chat service.
+// All implementations must embed UnimplementedChatServer
+// for forward compatibility
+type ChatServer interface {
+	ListChannels(context.Context, *ListChannelsReq) (*ListChannelsRes, error)
+	JoinChannel(context.Context, *JoinChannelReq) (*JoinChannelRes, error)
+	LeaveChannel(context.Context, *LeaveChannelReq) (*LeaveChannelRes, error)
+	SendChannelMessage(context.Context, *SendChannelMessageReq) (*SendChannelMessageRes, error)
+	GetChannelHistory(context.Context, *GetChannelHistoryReq) (*GetChannelHistoryRes, error)
+	ChannelMessages(*ChannelMessagesReq, Chat_ChannelMessagesServer) error
+	SendDirectMessage(context.Context, *SendDirectMessageReq) (*SendDirectMessageRes, error)
+	DirectMessages(*DirectMessagesReq, Chat_DirectMessagesServer) error
+	GetUnreadCounts(context.Context, *GetUnreadCountsReq) (*GetUnreadCountsRes, error)
+	MarkDirectMessagesRead(context.Context, *MarkDirectMessagesReadReq) (*MarkDirectMessagesReadRes, error)
+	mustEmbedUnimplementedChatServer()
+}
+
+// UnimplementedChatServer must be embedded to have forward compatible implementations.
+type UnimplementedChatServer struct {
+}
+
+func (UnimplementedChatServer) ListChannels(context.Context, *ListChannelsReq) (*ListChannelsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannels not implemented")
+}
+func (UnimplementedChatServer) JoinChannel(context.Context, *JoinChannelReq) (*JoinChannelRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinChannel not implemented")
+}
+func (UnimplementedChatServer) LeaveChannel(context.Context, *LeaveChannelReq) (*LeaveChannelRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveChannel not implemented")
+}
+func (UnimplementedChatServer) SendChannelMessage(context.Context, *SendChannelMessageReq) (*SendChannelMessageRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChannelMessage not implemented")
+}
+func (UnimplementedChatServer) GetChannelHistory(context.Context, *GetChannelHistoryReq) (*GetChannelHistoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelHistory not implemented")
+}
+func (UnimplementedChatServer) ChannelMessages(*ChannelMessagesReq, Chat_ChannelMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ChannelMessages not implemented")
+}
+func (UnimplementedChatServer) SendDirectMessage(context.Context, *SendDirectMessageReq) (*SendDirectMessageRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDirectMessage not implemented")
+}
+func (UnimplementedChatServer) DirectMessages(*DirectMessagesReq, Chat_DirectMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method DirectMessages not implemented")
+}
+func (UnimplementedChatServer) GetUnreadCounts(context.Context, *GetUnreadCountsReq) (*GetUnreadCountsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCounts not implemented")
+}
+func (UnimplementedChatServer) MarkDirectMessagesRead(context.Context, *MarkDirectMessagesReadReq) (*MarkDirectMessagesReadRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkDirectMessagesRead not implemented")
+}
+func (UnimplementedChatServer) mustEmbedUnimplementedChatServer() {}
+
+// UnsafeChatServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChatServer will
+// result in compilation errors.
+type UnsafeChatServer interface {
+	mustEmbedUnimplementedChatServer()
+}
+
+func RegisterChatServer(s grpc.ServiceRegistrar, srv ChatServer) {
+	s.RegisterService(&Chat_ServiceDesc, srv)
+}
+
+func _Chat_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chat/ListChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ListChannels(ctx, req.(*ListChannelsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_JoinChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinChannelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).JoinChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chat/JoinChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).JoinChannel(ctx, req.(*JoinChannelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_LeaveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveChannelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).LeaveChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chat/LeaveChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).LeaveChannel(ctx, req.(*LeaveChannelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SendChannelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChannelMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SendChannelMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chat/SendChannelMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SendChannelMessage(ctx, req.(*SendChannelMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetChannelHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetChannelHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chat/GetChannelHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetChannelHistory(ctx, req.(*GetChannelHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ChannelMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChannelMessagesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServer).ChannelMessages(m, &chatChannelMessagesServer{stream})
+}
+
+type Chat_ChannelMessagesServer interface {
+	Send(*ChannelMessage) error
+	grpc.ServerStream
+}
+
+type chatChannelMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *chatChannelMessagesServer) Send(m *ChannelMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chat_SendDirectMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendDirectMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SendDirectMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chat/SendDirectMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SendDirectMessage(ctx, req.(*SendDirectMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_DirectMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DirectMessagesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServer).DirectMessages(m, &chatDirectMessagesServer{stream})
+}
+
+type Chat_DirectMessagesServer interface {
+	Send(*DirectMessage) error
+	grpc.ServerStream
+}
+
+type chatDirectMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *chatDirectMessagesServer) Send(m *DirectMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chat_GetUnreadCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadCountsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetUnreadCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chat/GetUnreadCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetUnreadCounts(ctx, req.(*GetUnreadCountsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_MarkDirectMessagesRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkDirectMessagesReadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).MarkDirectMessagesRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chat/MarkDirectMessagesRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).MarkDirectMessagesRead(ctx, req.(*MarkDirectMessagesReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Chat_ServiceDesc is the grpc.ServiceDesc for Chat service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Chat_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Chat",
+	HandlerType: (*ChatServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListChannels",
+			Handler:    _Chat_ListChannels_Handler,
+		},
+		{
+			MethodName: "JoinChannel",
+			Handler:    _Chat_JoinChannel_Handler,
+		},
+		{
+			MethodName: "LeaveChannel",
+			Handler:    _Chat_LeaveChannel_Handler,
+		},
+		{
+			MethodName: "SendChannelMessage",
+			Handler:    _Chat_SendChannelMessage_Handler,
+		},
+		{
+			MethodName: "GetChannelHistory",
+			Handler:    _Chat_GetChannelHistory_Handler,
+		},
+		{
+			MethodName: "SendDirectMessage",
+			Handler:    _Chat_SendDirectMessage_Handler,
+		},
+		{
+			MethodName: "GetUnreadCounts",
+			Handler:    _Chat_GetUnreadCounts_Handler,
+		},
+		{
+			MethodName: "MarkDirectMessagesRead",
+			Handler:    _Chat_MarkDirectMessagesRead_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ChannelMessages",
+			Handler:       _Chat_ChannelMessages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DirectMessages",
+			Handler:       _Chat_DirectMessages_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/granny.proto",
+}
+
+// SocialClient is the client API for Social service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SocialClient interface {
+	SendFriendRequest(ctx context.Context, in *SendFriendRequestReq, opts ...grpc.CallOption) (*SendFriendRequestRes, error)
+	AcceptFriendRequest(ctx context.Context, in *AcceptFriendRequestReq, opts ...grpc.CallOption) (*AcceptFriendRequestRes, error)
+	DeclineFriendRequest(ctx context.Context, in *DeclineFriendRequestReq, opts ...grpc.CallOption) (*DeclineFriendRequestRes, error)
+	RemoveFriend(ctx context.Context, in *RemoveFriendReq, opts ...grpc.CallOption) (*RemoveFriendRes, error)
+	ListFriends(ctx context.Context, in *ListFriendsReq, opts ...grpc.CallOption) (*ListFriendsRes, error)
+	PresenceUpdates(ctx context.Context, in *PresenceUpdatesReq, opts ...grpc.CallOption) (Social_PresenceUpdatesClient, error)
+	BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*BlockUserRes, error)
+	UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*UnblockUserRes, error)
+	ListBlocked(ctx context.Context, in *ListBlockedReq, opts ...grpc.CallOption) (*ListBlockedRes, error)
+}
+
+type socialClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSocialClient(cc grpc.ClientConnInterface) SocialClient {
+	return &socialClient{cc}
+}
+
+func (c *socialClient) SendFriendRequest(ctx context.Context, in *SendFriendRequestReq, opts ...grpc.CallOption) (*SendFriendRequestRes, error) {
+	out := new(SendFriendRequestRes)
+	err := c.cc.Invoke(ctx, "/proto.Social/SendFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialClient) AcceptFriendRequest(ctx context.Context, in *AcceptFriendRequestReq, opts ...grpc.CallOption) (*AcceptFriendRequestRes, error) {
+	out := new(AcceptFriendRequestRes)
+	err := c.cc.Invoke(ctx, "/proto.Social/AcceptFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialClient) DeclineFriendRequest(ctx context.Context, in *DeclineFriendRequestReq, opts ...grpc.CallOption) (*DeclineFriendRequestRes, error) {
+	out := new(DeclineFriendRequestRes)
+	err := c.cc.Invoke(ctx, "/proto.Social/DeclineFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialClient) RemoveFriend(ctx context.Context, in *RemoveFriendReq, opts ...grpc.CallOption) (*RemoveFriendRes, error) {
+	out := new(RemoveFriendRes)
+	err := c.cc.Invoke(ctx, "/proto.Social/RemoveFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialClient) ListFriends(ctx context.Context, in *ListFriendsReq, opts ...grpc.CallOption) (*ListFriendsRes, error) {
+	out := new(ListFriendsRes)
+	err := c.cc.Invoke(ctx, "/proto.Social/ListFriends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialClient) PresenceUpdates(ctx context.Context, in *PresenceUpdatesReq, opts ...grpc.CallOption) (Social_PresenceUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Social_ServiceDesc.Streams[0], "/proto.Social/PresenceUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &socialPresenceUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Social_PresenceUpdatesClient interface {
+	Recv() (*Friend, error)
+	grpc.ClientStream
+}
+
+type socialPresenceUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *socialPresenceUpdatesClient) Recv() (*Friend, error) {
+	m := new(Friend)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *socialClient) BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*BlockUserRes, error) {
+	out := new(BlockUserRes)
+	err := c.cc.Invoke(ctx, "/proto.Social/BlockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialClient) UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*UnblockUserRes, error) {
+	out := new(UnblockUserRes)
+	err := c.cc.Invoke(ctx, "/proto.Social/UnblockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialClient) ListBlocked(ctx context.Context, in *ListBlockedReq, opts ...grpc.CallOption) (*ListBlockedRes, error) {
+	out := new(ListBlockedRes)
+	err := c.cc.Invoke(ctx, "/proto.Social/ListBlocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SocialServer is the server API for Social service.
+// All implementations must embed UnimplementedSocialServer
+// for forward compatibility
+type SocialServer interface {
+	SendFriendRequest(context.Context, *SendFriendRequestReq) (*SendFriendRequestRes, error)
+	AcceptFriendRequest(context.Context, *AcceptFriendRequestReq) (*AcceptFriendRequestRes, error)
+	DeclineFriendRequest(context.Context, *DeclineFriendRequestReq) (*DeclineFriendRequestRes, error)
+	RemoveFriend(context.Context, *RemoveFriendReq) (*RemoveFriendRes, error)
+	ListFriends(context.Context, *ListFriendsReq) (*ListFriendsRes, error)
+	PresenceUpdates(*PresenceUpdatesReq, Social_PresenceUpdatesServer) error
+	BlockUser(context.Context, *BlockUserReq) (*BlockUserRes, error)
+	UnblockUser(context.Context, *UnblockUserReq) (*UnblockUserRes, error)
+	ListBlocked(context.Context, *ListBlockedReq) (*ListBlockedRes, error)
+	mustEmbedUnimplementedSocialServer()
+}
+
+// UnimplementedSocialServer must be embedded to have forward compatible implementations.
+type UnimplementedSocialServer struct {
+}
+
+func (UnimplementedSocialServer) SendFriendRequest(context.Context, *SendFriendRequestReq) (*SendFriendRequestRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFriendRequest not implemented")
+}
+func (UnimplementedSocialServer) AcceptFriendRequest(context.Context, *AcceptFriendRequestReq) (*AcceptFriendRequestRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFriendRequest not implemented")
+}
+func (UnimplementedSocialServer) DeclineFriendRequest(context.Context, *DeclineFriendRequestReq) (*DeclineFriendRequestRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineFriendRequest not implemented")
+}
+func (UnimplementedSocialServer) RemoveFriend(context.Context, *RemoveFriendReq) (*RemoveFriendRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFriend not implemented")
+}
+func (UnimplementedSocialServer) ListFriends(context.Context, *ListFriendsReq) (*ListFriendsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFriends not implemented")
+}
+func (UnimplementedSocialServer) PresenceUpdates(*PresenceUpdatesReq, Social_PresenceUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method PresenceUpdates not implemented")
+}
+func (UnimplementedSocialServer) BlockUser(context.Context, *BlockUserReq) (*BlockUserRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedSocialServer) UnblockUser(context.Context, *UnblockUserReq) (*UnblockUserRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedSocialServer) ListBlocked(context.Context, *ListBlockedReq) (*ListBlockedRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlocked not implemented")
+}
+func (UnimplementedSocialServer) mustEmbedUnimplementedSocialServer() {}
+
+// UnsafeSocialServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SocialServer will
+// result in compilation errors.
+type UnsafeSocialServer interface {
+	mustEmbedUnimplementedSocialServer()
+}
+
+func RegisterSocialServer(s grpc.ServiceRegistrar, srv SocialServer) {
+	s.RegisterService(&Social_ServiceDesc, srv)
+}
+
+func _Social_SendFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendFriendRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServer).SendFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Social/SendFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServer).SendFriendRequest(ctx, req.(*SendFriendRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Social_AcceptFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptFriendRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServer).AcceptFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Social/AcceptFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServer).AcceptFriendRequest(ctx, req.(*AcceptFriendRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Social_DeclineFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineFriendRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServer).DeclineFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Social/DeclineFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServer).DeclineFriendRequest(ctx, req.(*DeclineFriendRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Social_RemoveFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFriendReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServer).RemoveFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Social/RemoveFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServer).RemoveFriend(ctx, req.(*RemoveFriendReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Social_ListFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFriendsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServer).ListFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Social/ListFriends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServer).ListFriends(ctx, req.(*ListFriendsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Social_PresenceUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PresenceUpdatesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SocialServer).PresenceUpdates(m, &socialPresenceUpdatesServer{stream})
+}
+
+type Social_PresenceUpdatesServer interface {
+	Send(*Friend) error
+	grpc.ServerStream
+}
+
+type socialPresenceUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *socialPresenceUpdatesServer) Send(m *Friend) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Social_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Social/BlockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServer).BlockUser(ctx, req.(*BlockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Social_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Social/UnblockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServer).UnblockUser(ctx, req.(*UnblockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Social_ListBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServer).ListBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Social/ListBlocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServer).ListBlocked(ctx, req.(*ListBlockedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Social_ServiceDesc is the grpc.ServiceDesc for Social service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Social_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Social",
+	HandlerType: (*SocialServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendFriendRequest",
+			Handler:    _Social_SendFriendRequest_Handler,
+		},
+		{
+			MethodName: "AcceptFriendRequest",
+			Handler:    _Social_AcceptFriendRequest_Handler,
+		},
+		{
+			MethodName: "DeclineFriendRequest",
+			Handler:    _Social_DeclineFriendRequest_Handler,
+		},
+		{
+			MethodName: "RemoveFriend",
+			Handler:    _Social_RemoveFriend_Handler,
+		},
+		{
+			MethodName: "ListFriends",
+			Handler:    _Social_ListFriends_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _Social_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _Social_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlocked",
+			Handler:    _Social_ListBlocked_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PresenceUpdates",
+			Handler:       _Social_PresenceUpdates_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/granny.proto",
+}
+
+// PartyClient is the client API for Party service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PartyClient interface {
+	CreateParty(ctx context.Context, in *CreatePartyReq, opts ...grpc.CallOption) (*CreatePartyRes, error)
+	GetParty(ctx context.Context, in *GetPartyReq, opts ...grpc.CallOption) (*GetPartyRes, error)
+	InviteToParty(ctx context.Context, in *InviteToPartyReq, opts ...grpc.CallOption) (*InviteToPartyRes, error)
+	AcceptPartyInvite(ctx context.Context, in *AcceptPartyInviteReq, opts ...grpc.CallOption) (*AcceptPartyInviteRes, error)
+	DeclinePartyInvite(ctx context.Context, in *DeclinePartyInviteReq, opts ...grpc.CallOption) (*DeclinePartyInviteRes, error)
+	LeaveParty(ctx context.Context, in *LeavePartyReq, opts ...grpc.CallOption) (*LeavePartyRes, error)
+	TransferPartyLeader(ctx context.Context, in *TransferPartyLeaderReq, opts ...grpc.CallOption) (*TransferPartyLeaderRes, error)
+	PartyEvents(ctx context.Context, in *PartyEventsReq, opts ...grpc.CallOption) (Party_PartyEventsClient, error)
+}
+
+type partyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPartyClient(cc grpc.ClientConnInterface) PartyClient {
+	return &partyClient{cc}
+}
+
+func (c *partyClient) CreateParty(ctx context.Context, in *CreatePartyReq, opts ...grpc.CallOption) (*CreatePartyRes, error) {
+	out := new(CreatePartyRes)
+	err := c.cc.Invoke(ctx, "/proto.Party/CreateParty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyClient) GetParty(ctx context.Context, in *GetPartyReq, opts ...grpc.CallOption) (*GetPartyRes, error) {
+	out := new(GetPartyRes)
+	err := c.cc.Invoke(ctx, "/proto.Party/GetParty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyClient) InviteToParty(ctx context.Context, in *InviteToPartyReq, opts ...grpc.CallOption) (*InviteToPartyRes, error) {
+	out := new(InviteToPartyRes)
+	err := c.cc.Invoke(ctx, "/proto.Party/InviteToParty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyClient) AcceptPartyInvite(ctx context.Context, in *AcceptPartyInviteReq, opts ...grpc.CallOption) (*AcceptPartyInviteRes, error) {
+	out := new(AcceptPartyInviteRes)
+	err := c.cc.Invoke(ctx, "/proto.Party/AcceptPartyInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyClient) DeclinePartyInvite(ctx context.Context, in *DeclinePartyInviteReq, opts ...grpc.CallOption) (*DeclinePartyInviteRes, error) {
+	out := new(DeclinePartyInviteRes)
+	err := c.cc.Invoke(ctx, "/proto.Party/DeclinePartyInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyClient) LeaveParty(ctx context.Context, in *LeavePartyReq, opts ...grpc.CallOption) (*LeavePartyRes, error) {
+	out := new(LeavePartyRes)
+	err := c.cc.Invoke(ctx, "/proto.Party/LeaveParty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyClient) TransferPartyLeader(ctx context.Context, in *TransferPartyLeaderReq, opts ...grpc.CallOption) (*TransferPartyLeaderRes, error) {
+	out := new(TransferPartyLeaderRes)
+	err := c.cc.Invoke(ctx, "/proto.Party/TransferPartyLeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partyClient) PartyEvents(ctx context.Context, in *PartyEventsReq, opts ...grpc.CallOption) (Party_PartyEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Party_ServiceDesc.Streams[0], "/proto.Party/PartyEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &partyPartyEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Party_PartyEventsClient interface {
+	Recv() (*PartyEvent, error)
+	grpc.ClientStream
+}
+
+type partyPartyEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *partyPartyEventsClient) Recv() (*PartyEvent, error) {
+	m := new(PartyEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PartyServer is the server API for Party service.
+// All implementations must embed UnimplementedPartyServer
+// for forward compatibility
+type PartyServer interface {
+	CreateParty(context.Context, *CreatePartyReq) (*CreatePartyRes, error)
+	GetParty(context.Context, *GetPartyReq) (*GetPartyRes, error)
+	InviteToParty(context.Context, *InviteToPartyReq) (*InviteToPartyRes, error)
+	AcceptPartyInvite(context.Context, *AcceptPartyInviteReq) (*AcceptPartyInviteRes, error)
+	DeclinePartyInvite(context.Context, *DeclinePartyInviteReq) (*DeclinePartyInviteRes, error)
+	LeaveParty(context.Context, *LeavePartyReq) (*LeavePartyRes, error)
+	TransferPartyLeader(context.Context, *TransferPartyLeaderReq) (*TransferPartyLeaderRes, error)
+	PartyEvents(*PartyEventsReq, Party_PartyEventsServer) error
+	mustEmbedUnimplementedPartyServer()
+}
+
+// UnimplementedPartyServer must be embedded to have forward compatible implementations.
+type UnimplementedPartyServer struct {
+}
+
+func (UnimplementedPartyServer) CreateParty(context.Context, *CreatePartyReq) (*CreatePartyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateParty not implemented")
+}
+func (UnimplementedPartyServer) GetParty(context.Context, *GetPartyReq) (*GetPartyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParty not implemented")
+}
+func (UnimplementedPartyServer) InviteToParty(context.Context, *InviteToPartyReq) (*InviteToPartyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToParty not implemented")
+}
+func (UnimplementedPartyServer) AcceptPartyInvite(context.Context, *AcceptPartyInviteReq) (*AcceptPartyInviteRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPartyInvite not implemented")
+}
+func (UnimplementedPartyServer) DeclinePartyInvite(context.Context, *DeclinePartyInviteReq) (*DeclinePartyInviteRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclinePartyInvite not implemented")
+}
+func (UnimplementedPartyServer) LeaveParty(context.Context, *LeavePartyReq) (*LeavePartyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveParty not implemented")
+}
+func (UnimplementedPartyServer) TransferPartyLeader(context.Context, *TransferPartyLeaderReq) (*TransferPartyLeaderRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPartyLeader not implemented")
+}
+func (UnimplementedPartyServer) PartyEvents(*PartyEventsReq, Party_PartyEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method PartyEvents not implemented")
+}
+func (UnimplementedPartyServer) mustEmbedUnimplementedPartyServer() {}
+
+// UnsafePartyServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PartyServer will
+// result in compilation errors.
+type UnsafePartyServer interface {
+	mustEmbedUnimplementedPartyServer()
+}
+
+func RegisterPartyServer(s grpc.ServiceRegistrar, srv PartyServer) {
+	s.RegisterService(&Party_ServiceDesc, srv)
+}
+
+func _Party_CreateParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServer).CreateParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Party/CreateParty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServer).CreateParty(ctx, req.(*CreatePartyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Party_GetParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServer).GetParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Party/GetParty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServer).GetParty(ctx, req.(*GetPartyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Party_InviteToParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToPartyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServer).InviteToParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Party/InviteToParty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServer).InviteToParty(ctx, req.(*InviteToPartyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Party_AcceptPartyInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptPartyInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServer).AcceptPartyInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Party/AcceptPartyInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServer).AcceptPartyInvite(ctx, req.(*AcceptPartyInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Party_DeclinePartyInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclinePartyInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServer).DeclinePartyInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Party/DeclinePartyInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServer).DeclinePartyInvite(ctx, req.(*DeclinePartyInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Party_LeaveParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeavePartyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServer).LeaveParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Party/LeaveParty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServer).LeaveParty(ctx, req.(*LeavePartyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Party_TransferPartyLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferPartyLeaderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartyServer).TransferPartyLeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Party/TransferPartyLeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartyServer).TransferPartyLeader(ctx, req.(*TransferPartyLeaderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Party_PartyEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PartyEventsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PartyServer).PartyEvents(m, &partyPartyEventsServer{stream})
+}
+
+type Party_PartyEventsServer interface {
+	Send(*PartyEvent) error
+	grpc.ServerStream
+}
+
+type partyPartyEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *partyPartyEventsServer) Send(m *PartyEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Party_ServiceDesc is the grpc.ServiceDesc for Party service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Party_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Party",
+	HandlerType: (*PartyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateParty",
+			Handler:    _Party_CreateParty_Handler,
+		},
+		{
+			MethodName: "GetParty",
+			Handler:    _Party_GetParty_Handler,
+		},
+		{
+			MethodName: "InviteToParty",
+			Handler:    _Party_InviteToParty_Handler,
+		},
+		{
+			MethodName: "AcceptPartyInvite",
+			Handler:    _Party_AcceptPartyInvite_Handler,
+		},
+		{
+			MethodName: "DeclinePartyInvite",
+			Handler:    _Party_DeclinePartyInvite_Handler,
+		},
+		{
+			MethodName: "LeaveParty",
+			Handler:    _Party_LeaveParty_Handler,
+		},
+		{
+			MethodName: "TransferPartyLeader",
+			Handler:    _Party_TransferPartyLeader_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PartyEvents",
+			Handler:       _Party_PartyEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/granny.proto",
+}
+
+// GuildClient is the client API for Guild service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GuildClient interface {
+	CreateGuild(ctx context.Context, in *CreateGuildReq, opts ...grpc.CallOption) (*CreateGuildRes, error)
+	GetGuild(ctx context.Context, in *GetGuildReq, opts ...grpc.CallOption) (*GetGuildRes, error)
+	InviteToGuild(ctx context.Context, in *InviteToGuildReq, opts ...grpc.CallOption) (*InviteToGuildRes, error)
+	ListGuildInvites(ctx context.Context, in *ListGuildInvitesReq, opts ...grpc.CallOption) (*ListGuildInvitesRes, error)
+	AcceptGuildInvite(ctx context.Context, in *AcceptGuildInviteReq, opts ...grpc.CallOption) (*AcceptGuildInviteRes, error)
+	DeclineGuildInvite(ctx context.Context, in *DeclineGuildInviteReq, opts ...grpc.CallOption) (*DeclineGuildInviteRes, error)
+	ApplyToGuild(ctx context.Context, in *ApplyToGuildReq, opts ...grpc.CallOption) (*ApplyToGuildRes, error)
+	AcceptGuildApplication(ctx context.Context, in *AcceptGuildApplicationReq, opts ...grpc.CallOption) (*AcceptGuildApplicationRes, error)
+	DeclineGuildApplication(ctx context.Context, in *DeclineGuildApplicationReq, opts ...grpc.CallOption) (*DeclineGuildApplicationRes, error)
+	LeaveGuild(ctx context.Context, in *LeaveGuildReq, opts ...grpc.CallOption) (*LeaveGuildRes, error)
+	KickGuildMember(ctx context.Context, in *KickGuildMemberReq, opts ...grpc.CallOption) (*KickGuildMemberRes, error)
+	SetGuildRank(ctx context.Context, in *SetGuildRankReq, opts ...grpc.CallOption) (*SetGuildRankRes, error)
+	SetGuildMotd(ctx context.Context, in *SetGuildMotdReq, opts ...grpc.CallOption) (*SetGuildMotdRes, error)
+	DisbandGuild(ctx context.Context, in *DisbandGuildReq, opts ...grpc.CallOption) (*DisbandGuildRes, error)
+}
+
+type guildClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGuildClient(cc grpc.ClientConnInterface) GuildClient {
+	return &guildClient{cc}
+}
+
+func (c *guildClient) CreateGuild(ctx context.Context, in *CreateGuildReq, opts ...grpc.CallOption) (*CreateGuildRes, error) {
+	out := new(CreateGuildRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/CreateGuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildClient) GetGuild(ctx context.Context, in *GetGuildReq, opts ...grpc.CallOption) (*GetGuildRes, error) {
+	out := new(GetGuildRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/GetGuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildClient) InviteToGuild(ctx context.Context, in *InviteToGuildReq, opts ...grpc.CallOption) (*InviteToGuildRes, error) {
+	out := new(InviteToGuildRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/InviteToGuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildClient) ListGuildInvites(ctx context.Context, in *ListGuildInvitesReq, opts ...grpc.CallOption) (*ListGuildInvitesRes, error) {
+	out := new(ListGuildInvitesRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/ListGuildInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildClient) AcceptGuildInvite(ctx context.Context, in *AcceptGuildInviteReq, opts ...grpc.CallOption) (*AcceptGuildInviteRes, error) {
+	out := new(AcceptGuildInviteRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/AcceptGuildInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildClient) DeclineGuildInvite(ctx context.Context, in *DeclineGuildInviteReq, opts ...grpc.CallOption) (*DeclineGuildInviteRes, error) {
+	out := new(DeclineGuildInviteRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/DeclineGuildInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildClient) ApplyToGuild(ctx context.Context, in *ApplyToGuildReq, opts ...grpc.CallOption) (*ApplyToGuildRes, error) {
+	out := new(ApplyToGuildRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/ApplyToGuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildClient) AcceptGuildApplication(ctx context.Context, in *AcceptGuildApplicationReq, opts ...grpc.CallOption) (*AcceptGuildApplicationRes, error) {
+	out := new(AcceptGuildApplicationRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/AcceptGuildApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildClient) DeclineGuildApplication(ctx context.Context, in *DeclineGuildApplicationReq, opts ...grpc.CallOption) (*DeclineGuildApplicationRes, error) {
+	out := new(DeclineGuildApplicationRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/DeclineGuildApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildClient) LeaveGuild(ctx context.Context, in *LeaveGuildReq, opts ...grpc.CallOption) (*LeaveGuildRes, error) {
+	out := new(LeaveGuildRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/LeaveGuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildClient) KickGuildMember(ctx context.Context, in *KickGuildMemberReq, opts ...grpc.CallOption) (*KickGuildMemberRes, error) {
+	out := new(KickGuildMemberRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/KickGuildMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildClient) SetGuildRank(ctx context.Context, in *SetGuildRankReq, opts ...grpc.CallOption) (*SetGuildRankRes, error) {
+	out := new(SetGuildRankRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/SetGuildRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildClient) SetGuildMotd(ctx context.Context, in *SetGuildMotdReq, opts ...grpc.CallOption) (*SetGuildMotdRes, error) {
+	out := new(SetGuildMotdRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/SetGuildMotd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildClient) DisbandGuild(ctx context.Context, in *DisbandGuildReq, opts ...grpc.CallOption) (*DisbandGuildRes, error) {
+	out := new(DisbandGuildRes)
+	err := c.cc.Invoke(ctx, "/proto.Guild/DisbandGuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GuildServer is the server API for Guild service.
+// All implementations must embed UnimplementedGuildServer
+// for forward compatibility
+type GuildServer interface {
+	CreateGuild(context.Context, *CreateGuildReq) (*CreateGuildRes, error)
+	GetGuild(context.Context, *GetGuildReq) (*GetGuildRes, error)
+	InviteToGuild(context.Context, *InviteToGuildReq) (*InviteToGuildRes, error)
+	ListGuildInvites(context.Context, *ListGuildInvitesReq) (*ListGuildInvitesRes, error)
+	AcceptGuildInvite(context.Context, *AcceptGuildInviteReq) (*AcceptGuildInviteRes, error)
+	DeclineGuildInvite(context.Context, *DeclineGuildInviteReq) (*DeclineGuildInviteRes, error)
+	ApplyToGuild(context.Context, *ApplyToGuildReq) (*ApplyToGuildRes, error)
+	AcceptGuildApplication(context.Context, *AcceptGuildApplicationReq) (*AcceptGuildApplicationRes, error)
+	DeclineGuildApplication(context.Context, *DeclineGuildApplicationReq) (*DeclineGuildApplicationRes, error)
+	LeaveGuild(context.Context, *LeaveGuildReq) (*LeaveGuildRes, error)
+	KickGuildMember(context.Context, *KickGuildMemberReq) (*KickGuildMemberRes, error)
+	SetGuildRank(context.Context, *SetGuildRankReq) (*SetGuildRankRes, error)
+	SetGuildMotd(context.Context, *SetGuildMotdReq) (*SetGuildMotdRes, error)
+	DisbandGuild(context.Context, *DisbandGuildReq) (*DisbandGuildRes, error)
+	mustEmbedUnimplementedGuildServer()
+}
+
+// UnimplementedGuildServer must be embedded to have forward compatible implementations.
+type UnimplementedGuildServer struct {
+}
+
+func (UnimplementedGuildServer) CreateGuild(context.Context, *CreateGuildReq) (*CreateGuildRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuild not implemented")
+}
+func (UnimplementedGuildServer) GetGuild(context.Context, *GetGuildReq) (*GetGuildRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuild not implemented")
+}
+func (UnimplementedGuildServer) InviteToGuild(context.Context, *InviteToGuildReq) (*InviteToGuildRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToGuild not implemented")
+}
+func (UnimplementedGuildServer) ListGuildInvites(context.Context, *ListGuildInvitesReq) (*ListGuildInvitesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGuildInvites not implemented")
+}
+func (UnimplementedGuildServer) AcceptGuildInvite(context.Context, *AcceptGuildInviteReq) (*AcceptGuildInviteRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptGuildInvite not implemented")
+}
+func (UnimplementedGuildServer) DeclineGuildInvite(context.Context, *DeclineGuildInviteReq) (*DeclineGuildInviteRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineGuildInvite not implemented")
+}
+func (UnimplementedGuildServer) ApplyToGuild(context.Context, *ApplyToGuildReq) (*ApplyToGuildRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyToGuild not implemented")
+}
+func (UnimplementedGuildServer) AcceptGuildApplication(context.Context, *AcceptGuildApplicationReq) (*AcceptGuildApplicationRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptGuildApplication not implemented")
+}
+func (UnimplementedGuildServer) DeclineGuildApplication(context.Context, *DeclineGuildApplicationReq) (*DeclineGuildApplicationRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineGuildApplication not implemented")
+}
+func (UnimplementedGuildServer) LeaveGuild(context.Context, *LeaveGuildReq) (*LeaveGuildRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGuild not implemented")
+}
+func (UnimplementedGuildServer) KickGuildMember(context.Context, *KickGuildMemberReq) (*KickGuildMemberRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickGuildMember not implemented")
+}
+func (UnimplementedGuildServer) SetGuildRank(context.Context, *SetGuildRankReq) (*SetGuildRankRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGuildRank not implemented")
+}
+func (UnimplementedGuildServer) SetGuildMotd(context.Context, *SetGuildMotdReq) (*SetGuildMotdRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGuildMotd not implemented")
+}
+func (UnimplementedGuildServer) DisbandGuild(context.Context, *DisbandGuildReq) (*DisbandGuildRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisbandGuild not implemented")
+}
+func (UnimplementedGuildServer) mustEmbedUnimplementedGuildServer() {}
+
+// UnsafeGuildServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GuildServer will
+// result in compilation errors.
+type UnsafeGuildServer interface {
+	mustEmbedUnimplementedGuildServer()
+}
+
+func RegisterGuildServer(s grpc.ServiceRegistrar, srv GuildServer) {
+	s.RegisterService(&Guild_ServiceDesc, srv)
+}
+
+func _Guild_CreateGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuildReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).CreateGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/CreateGuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).CreateGuild(ctx, req.(*CreateGuildReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Guild_GetGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).GetGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/GetGuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).GetGuild(ctx, req.(*GetGuildReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Guild_InviteToGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToGuildReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).InviteToGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/InviteToGuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).InviteToGuild(ctx, req.(*InviteToGuildReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Guild_ListGuildInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGuildInvitesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).ListGuildInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/ListGuildInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).ListGuildInvites(ctx, req.(*ListGuildInvitesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Guild_AcceptGuildInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptGuildInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).AcceptGuildInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/AcceptGuildInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).AcceptGuildInvite(ctx, req.(*AcceptGuildInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Guild_DeclineGuildInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineGuildInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).DeclineGuildInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/DeclineGuildInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).DeclineGuildInvite(ctx, req.(*DeclineGuildInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Guild_ApplyToGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyToGuildReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).ApplyToGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/ApplyToGuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).ApplyToGuild(ctx, req.(*ApplyToGuildReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Guild_AcceptGuildApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptGuildApplicationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).AcceptGuildApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/AcceptGuildApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).AcceptGuildApplication(ctx, req.(*AcceptGuildApplicationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Guild_DeclineGuildApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineGuildApplicationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).DeclineGuildApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/DeclineGuildApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).DeclineGuildApplication(ctx, req.(*DeclineGuildApplicationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Guild_LeaveGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGuildReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).LeaveGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/LeaveGuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).LeaveGuild(ctx, req.(*LeaveGuildReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Guild_KickGuildMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickGuildMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).KickGuildMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/KickGuildMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).KickGuildMember(ctx, req.(*KickGuildMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Guild_SetGuildRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGuildRankReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).SetGuildRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/SetGuildRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).SetGuildRank(ctx, req.(*SetGuildRankReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Guild_SetGuildMotd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGuildMotdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).SetGuildMotd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/SetGuildMotd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).SetGuildMotd(ctx, req.(*SetGuildMotdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Guild_DisbandGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisbandGuildReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServer).DisbandGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Guild/DisbandGuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServer).DisbandGuild(ctx, req.(*DisbandGuildReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Guild_ServiceDesc is the grpc.ServiceDesc for Guild service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Guild_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Guild",
+	HandlerType: (*GuildServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGuild",
+			Handler:    _Guild_CreateGuild_Handler,
+		},
+		{
+			MethodName: "GetGuild",
+			Handler:    _Guild_GetGuild_Handler,
+		},
+		{
+			MethodName: "InviteToGuild",
+			Handler:    _Guild_InviteToGuild_Handler,
+		},
+		{
+			MethodName: "ListGuildInvites",
+			Handler:    _Guild_ListGuildInvites_Handler,
+		},
+		{
+			MethodName: "AcceptGuildInvite",
+			Handler:    _Guild_AcceptGuildInvite_Handler,
+		},
+		{
+			MethodName: "DeclineGuildInvite",
+			Handler:    _Guild_DeclineGuildInvite_Handler,
+		},
+		{
+			MethodName: "ApplyToGuild",
+			Handler:    _Guild_ApplyToGuild_Handler,
+		},
+		{
+			MethodName: "AcceptGuildApplication",
+			Handler:    _Guild_AcceptGuildApplication_Handler,
+		},
+		{
+			MethodName: "DeclineGuildApplication",
+			Handler:    _Guild_DeclineGuildApplication_Handler,
+		},
+		{
+			MethodName: "LeaveGuild",
+			Handler:    _Guild_LeaveGuild_Handler,
+		},
+		{
+			MethodName: "KickGuildMember",
+			Handler:    _Guild_KickGuildMember_Handler,
+		},
+		{
+			MethodName: "SetGuildRank",
+			Handler:    _Guild_SetGuildRank_Handler,
+		},
+		{
+			MethodName: "SetGuildMotd",
+			Handler:    _Guild_SetGuildMotd_Handler,
+		},
+		{
+			MethodName: "DisbandGuild",
+			Handler:    _Guild_DisbandGuild_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/granny.proto",
+}
+
+// WorldClient is the client API for World service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorldClient interface {
+	GetMapInfo(ctx context.Context, in *GetMapInfoReq, opts ...grpc.CallOption) (*GetMapInfoRes, error)
+	GetChunk(ctx context.Context, in *GetChunkReq, opts ...grpc.CallOption) (World_GetChunkClient, error)
+}
+
+type worldClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorldClient(cc grpc.ClientConnInterface) WorldClient {
+	return &worldClient{cc}
+}
+
+func (c *worldClient) GetMapInfo(ctx context.Context, in *GetMapInfoReq, opts ...grpc.CallOption) (*GetMapInfoRes, error) {
+	out := new(GetMapInfoRes)
+	err := c.cc.Invoke(ctx, "/proto.World/GetMapInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldClient) GetChunk(ctx context.Context, in *GetChunkReq, opts ...grpc.CallOption) (World_GetChunkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &World_ServiceDesc.Streams[0], "/proto.World/GetChunk", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &worldGetChunkClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type World_GetChunkClient interface {
+	Recv() (*MapChunk, error)
+	grpc.ClientStream
+}
+
+type worldGetChunkClient struct {
+	grpc.ClientStream
+}
+
+func (x *worldGetChunkClient) Recv() (*MapChunk, error) {
+	m := new(MapChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WorldServer is the server API for World service.
+// All implementations must embed UnimplementedWorldServer
+// for forward compatibility
+type WorldServer interface {
+	GetMapInfo(context.Context, *GetMapInfoReq) (*GetMapInfoRes, error)
+	GetChunk(*GetChunkReq, World_GetChunkServer) error
+	mustEmbedUnimplementedWorldServer()
+}
+
+// UnimplementedWorldServer must be embedded to have forward compatible implementations.
+type UnimplementedWorldServer struct {
+}
+
+func (UnimplementedWorldServer) GetMapInfo(context.Context, *GetMapInfoReq) (*GetMapInfoRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMapInfo not implemented")
+}
+func (UnimplementedWorldServer) GetChunk(*GetChunkReq, World_GetChunkServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetChunk not implemented")
+}
+func (UnimplementedWorldServer) mustEmbedUnimplementedWorldServer() {}
+
+// UnsafeWorldServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorldServer will
+// result in compilation errors.
+type UnsafeWorldServer interface {
+	mustEmbedUnimplementedWorldServer()
+}
+
+func RegisterWorldServer(s grpc.ServiceRegistrar, srv WorldServer) {
+	s.RegisterService(&World_ServiceDesc, srv)
+}
+
+func _World_GetMapInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMapInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldServer).GetMapInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.World/GetMapInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldServer).GetMapInfo(ctx, req.(*GetMapInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _World_GetChunk_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetChunkReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorldServer).GetChunk(m, &worldGetChunkServer{stream})
+}
+
+type World_GetChunkServer interface {
+	Send(*MapChunk) error
+	grpc.ServerStream
+}
+
+type worldGetChunkServer struct {
+	grpc.ServerStream
+}
+
+func (x *worldGetChunkServer) Send(m *MapChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// World_ServiceDesc is the grpc.ServiceDesc for World service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var World_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.World",
+	HandlerType: (*WorldServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMapInfo",
+			Handler:    _World_GetMapInfo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetChunk",
+			Handler:       _World_GetChunk_Handler,
 			ServerStreams: true,
 		},
 	},
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter limits how many actions each user can take within a window of time.
+type RateLimiter struct {
+	limit     int
+	window    time.Duration
+	hits      map[int][]time.Time // User ID to the times of their actions within the window
+	lastSweep time.Time           // Last time users with no actions within the window were removed
+	mut       sync.Mutex
+}
+
+func newRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:     limit,
+		window:    window,
+		hits:      make(map[int][]time.Time),
+		lastSweep: time.Now(),
+	}
+}
+
+// Record an action by the user. Return false if the user has already reached the limit.
+func (rl *RateLimiter) allow(userID int) bool {
+	rl.mut.Lock()
+	defer rl.mut.Unlock()
+
+	now := time.Now()
+	rl.sweep(now)
+
+	// Forget actions that are outside of the window
+	hits := rl.hits[userID]
+	for len(hits) > 0 && now.Sub(hits[0]) > rl.window {
+		hits = hits[1:]
+	}
+
+	if len(hits) >= rl.limit {
+		rl.hits[userID] = hits
+		return false
+	}
+
+	rl.hits[userID] = append(hits, now)
+	return true
+}
+
+// Remove the users whose last action is outside of the window, at most once per window so the map does not
+// keep every user that ever acted. mut must be locked first.
+func (rl *RateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) <= rl.window {
+		return
+	}
+	rl.lastSweep = now
+
+	for userID, hits := range rl.hits {
+		if len(hits) == 0 || now.Sub(hits[len(hits)-1]) > rl.window {
+			delete(rl.hits, userID)
+		}
+	}
+}
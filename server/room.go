@@ -3,48 +3,492 @@ package main
 import (
 	"errors"
 	"sync"
+	"time"
+
+	"github.com/cdrpl/granny/server/proto"
+)
+
+var (
+	errNotHost      = errors.New("Only the host can do that")
+	errNotInRoom    = errors.New("User is not in the room")
+	errNotOpen      = errors.New("Room is no longer open")
+	errRoomTooBig   = errors.New("Capacity is less than the number of users in the room")
+	errChatRate     = errors.New("Sending messages too fast")
+	errRoomTooSmall = errors.New("Room does not have space for the whole party")
 )
 
+// RoomSettings are the configurable options of a room.
+type RoomSettings struct {
+	Name           string
+	Capacity       int
+	Public         bool
+	Tags           []string
+	ReadyQuorum    int           // Num ready users needed to start the countdown, zero means every user
+	SpectatorDelay time.Duration // How far behind the players spectators receive events
+}
+
 // Room represents a game room.
 type Room struct {
-	users map[int]*RoomUser
-	mut   sync.Mutex
+	id          string
+	settings    RoomSettings
+	state       proto.RoomState
+	hostID      int
+	seq         int64                // Sequence number of the last broadcast event
+	history     []*proto.RoomEvent   // Recent events kept for replaying to reconnecting users
+	chat        []*proto.ChatMessage // Recent chat messages sent to users when they join
+	chatLimiter *RateLimiter         // Limits how fast each user can send chat messages
+	countdown   *time.Timer          // Fires when the countdown ends, nil when there is no countdown
+	sim         *Simulation          // Game state while the game is in progress, nil otherwise
+	lastShown   time.Time            // Time the last snapshot was published for spectators
+	tickRate    int                  // Simulation ticks per second
+	world       *TileMap             // Map the game is played on, nil for an empty world
+	createdAt   time.Time
+	users       map[int]*RoomUser
+	onEvent     func(*proto.RoomEvent, *proto.RoomInfo) // Called with every broadcast event and the room info after the event, must not block
+	onSnapshot  func(*proto.RoomEvent)                  // Called with a full snapshot for spectators, must not block
+	mut         sync.Mutex
 }
 
-func newRoom() Room {
-	return Room{
-		users: make(map[int]*RoomUser),
+func newRoom(id string, settings RoomSettings) *Room {
+	return &Room{
+		id:          id,
+		settings:    settings,
+		state:       proto.RoomState_ROOM_STATE_OPEN,
+		createdAt:   time.Now(),
+		users:       make(map[int]*RoomUser),
+		tickRate:    defaultTickRate,
+		chatLimiter: newRateLimiter(chatRateLimit, chatRateWindow),
 	}
 }
 
 // Check if room is full, mut must be locked first.
 func (r *Room) isFull() bool {
-	return len(r.users) >= roomSize
+	return !r.hasSpace(1)
+}
+
+// Check if n more users fit in the room, mut must be locked first.
+func (r *Room) hasSpace(n int) bool {
+	return len(r.users)+n <= r.settings.Capacity
 }
 
 func (r *Room) joinRoom(user *RoomUser) error {
+	return r.joinUsers([]*RoomUser{user})
+}
+
+// Add every user to the room or none of them. Used to keep parties together.
+func (r *Room) joinUsers(users []*RoomUser) error {
 	r.mut.Lock()
 	defer r.mut.Unlock()
 
-	roomIsFull := r.isFull()
-	if roomIsFull {
+	if r.state != proto.RoomState_ROOM_STATE_OPEN {
+		return errNotOpen
+	}
+
+	if r.isFull() {
 		return errors.New("Room is full")
+	} else if !r.hasSpace(len(users)) {
+		return errRoomTooSmall
+	}
+
+	for _, user := range users {
+		if _, ok := r.users[user.id]; ok {
+			return errors.New("User is already in the room")
+		}
+	}
+
+	for _, user := range users {
+		user.joinedAt = time.Now()
+		r.users[user.id] = user
+
+		// The first user to join an empty room becomes the host
+		if len(r.users) == 1 {
+			r.hostID = user.id
+		}
+
+		// Send user joined to the UserJoined stream of every user, joins are dropped once a user's buffer is full
+		for _, ru := range r.users {
+			select {
+			case ru.joined <- user:
+			default:
+			}
+		}
+
+		r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_UserJoined{UserJoined: user.proto()}})
+	}
+
+	return nil
+}
+
+// Remove the user from the room, the host is migrated if the host left.
+// Return true if the room is empty afterwards.
+func (r *Room) leaveRoom(id int) (bool, error) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return len(r.users) == 0, errNotInRoom
+	}
+
+	r.removeUser(user)
+	r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_UserLeft{UserLeft: user.proto()}})
+	r.migrateHost()
+	r.userGone()
+
+	return len(r.users) == 0, nil
+}
+
+// Remove a user from the room, only the host can kick users.
+func (r *Room) kickUser(hostID, id int) error {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	if hostID != r.hostID {
+		return errNotHost
+	}
+
+	user, ok := r.users[id]
+	if !ok {
+		return errNotInRoom
+	} else if id == hostID {
+		return errors.New("The host cannot kick themselves")
+	}
+
+	// Broadcast before removing so the kicked user also receives the event
+	r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_UserKicked{UserKicked: user.proto()}})
+	r.removeUser(user)
+	r.userGone()
+
+	return nil
+}
+
+// Change the room settings, only the host can change settings.
+func (r *Room) updateSettings(hostID int, settings RoomSettings) (*proto.RoomInfo, error) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	if hostID != r.hostID {
+		return nil, errNotHost
+	} else if r.state != proto.RoomState_ROOM_STATE_OPEN {
+		return nil, errNotOpen
+	} else if settings.Capacity < len(r.users) {
+		return nil, errRoomTooBig
+	}
+
+	r.settings = settings
+
+	info := r.roomInfo()
+	r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_SettingsChanged{SettingsChanged: info}})
+	r.checkReady()
+
+	return info, nil
+}
+
+// Start the countdown without waiting for users to be ready, only the host can start the game.
+func (r *Room) startGame(hostID int) error {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	if hostID != r.hostID {
+		return errNotHost
+	} else if r.state != proto.RoomState_ROOM_STATE_OPEN {
+		return errNotOpen
+	}
+
+	r.startCountdown()
+	return nil
+}
+
+// Change the ready state of a user. The countdown starts once enough users are ready and is cancelled if a user unreadies.
+func (r *Room) setReady(id int, ready bool) error {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return errNotInRoom
+	} else if r.state == proto.RoomState_ROOM_STATE_IN_PROGRESS {
+		return errNotOpen
+	} else if user.ready == ready {
+		return nil
+	}
+
+	user.ready = ready
+	r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_ReadyChanged{ReadyChanged: &proto.ReadyChanged{User: user.proto(), Ready: ready}}})
+
+	if ready {
+		r.checkReady()
+	} else {
+		r.cancelCountdown()
+	}
+
+	return nil
+}
+
+// Start the countdown if enough users are ready, mut must be locked first.
+func (r *Room) checkReady() {
+	if r.state != proto.RoomState_ROOM_STATE_OPEN || len(r.users) == 0 {
+		return
+	}
+
+	quorum := r.settings.ReadyQuorum
+	if quorum == 0 {
+		quorum = len(r.users)
+	}
+
+	ready := 0
+	for _, user := range r.users {
+		if user.ready {
+			ready++
+		}
+	}
+
+	if ready >= quorum {
+		r.startCountdown()
+	}
+}
+
+// Start the countdown to the game, mut must be locked first.
+func (r *Room) startCountdown() {
+	var timer *time.Timer
+	timer = time.AfterFunc(countdownTime, func() { r.endCountdown(timer) })
+
+	r.countdown = timer
+	r.state = proto.RoomState_ROOM_STATE_COUNTDOWN
+
+	endsAt := time.Now().Add(countdownTime).UnixNano() / int64(time.Millisecond)
+	r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_CountdownStarted{CountdownStarted: &proto.CountdownStarted{EndsAt: endsAt}}})
+}
+
+// Start the game when the countdown timer fires.
+func (r *Room) endCountdown(timer *time.Timer) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	// The countdown may have been cancelled while waiting for the lock
+	if r.countdown != timer {
+		return
+	}
+
+	r.countdown = nil
+	r.state = proto.RoomState_ROOM_STATE_IN_PROGRESS
+	r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_GameStarted{GameStarted: &proto.GameStarted{}}})
+	r.startSimulation()
+}
+
+// Cancel the countdown if there is one, mut must be locked first.
+func (r *Room) cancelCountdown() {
+	if r.state != proto.RoomState_ROOM_STATE_COUNTDOWN {
+		return
+	}
+
+	r.countdown.Stop()
+	r.countdown = nil
+	r.state = proto.RoomState_ROOM_STATE_OPEN
+	r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_CountdownCancelled{CountdownCancelled: &proto.CountdownCancelled{}}})
+}
+
+// A user has left the room, the countdown is cancelled or the remaining users may now be ready.
+// mut must be locked first.
+func (r *Room) userGone() {
+	if r.state == proto.RoomState_ROOM_STATE_COUNTDOWN {
+		r.cancelCountdown()
+	} else {
+		r.checkReady()
+	}
+}
+
+// Attach a new event stream for the user, any previously attached stream is closed.
+// Events after the since sequence number are returned for replay, no events are replayed if since is negative.
+func (r *Room) attach(id int, since int64) (chan *proto.RoomEvent, []*proto.RoomEvent, error) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return nil, nil, errNotInRoom
 	}
 
-	if _, ok := r.users[user.id]; ok {
-		return errors.New("User is already in the room")
+	var replay []*proto.RoomEvent
+	if since >= 0 {
+		// History does not survive restarts so it may not go back as far as the sequence number
+		oldest := r.seq + 1
+		if len(r.history) > 0 {
+			oldest = r.history[0].Seq
+		}
+		if since < oldest-1 {
+			return nil, nil, errors.New("Missed events are no longer available")
+		}
+
+		for _, event := range r.history {
+			if event.Seq > since {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	close(user.events)
+	user.events = make(chan *proto.RoomEvent, roomEventBuffer)
+
+	if user.evict != nil {
+		user.evict.Stop()
+		user.evict = nil
 	}
 
-	r.users[user.id] = user
+	if !user.connected {
+		user.connected = true
+		r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_UserReconnected{UserReconnected: user.proto()}})
+	}
+
+	return user.events, replay, nil
+}
+
+// Mark the user as disconnected if events is still their attached stream.
+// The evict function is called with the disconnect number if the user does not reconnect within the grace period.
+func (r *Room) disconnect(id int, events chan *proto.RoomEvent, evict func(int)) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
 
-	// Broadcast user joined to every other user
-	for _, ru := range r.users {
-		ru.joined <- user
+	user, ok := r.users[id]
+	if !ok || user.events != events {
+		return
 	}
 
+	user.connected = false
+	user.disconnects++
+	r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_UserDisconnected{UserDisconnected: user.proto()}})
+
+	disconnects := user.disconnects
+	user.evict = time.AfterFunc(reconnectGrace, func() { evict(disconnects) })
+}
+
+// Remove the user if they are still disconnected from the given disconnect.
+// Return true if the room is empty afterwards.
+func (r *Room) evictUser(id int, disconnects int) (bool, error) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	user, ok := r.users[id]
+	if !ok || user.connected || user.disconnects != disconnects {
+		return len(r.users) == 0, errors.New("User has reconnected")
+	}
+
+	r.removeUser(user)
+	r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_UserLeft{UserLeft: user.proto()}})
+	r.migrateHost()
+	r.userGone()
+
+	return len(r.users) == 0, nil
+}
+
+// Remove the user and close their event channel, mut must be locked first.
+func (r *Room) removeUser(user *RoomUser) {
+	delete(r.users, user.id)
+	close(user.events)
+
+	if r.sim != nil {
+		r.sim.removeEntity(user.id)
+	}
+
+	if user.evict != nil {
+		user.evict.Stop()
+	}
+}
+
+// Give host status to the longest present user if the host is gone, mut must be locked first.
+// Ties are broken by the lowest user ID so every server picks the same host.
+func (r *Room) migrateHost() {
+	if _, ok := r.users[r.hostID]; ok || len(r.users) == 0 {
+		return
+	}
+
+	var host *RoomUser
+	for _, user := range r.users {
+		if host == nil || user.joinedAt.Before(host.joinedAt) || (user.joinedAt.Equal(host.joinedAt) && user.id < host.id) {
+			host = user
+		}
+	}
+
+	r.hostID = host.id
+	r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_HostChanged{HostChanged: host.proto()}})
+}
+
+// Send the event to every user in the room, mut must be locked first.
+// Users that are not keeping up with their events are skipped, they can catch up by resuming from the history.
+func (r *Room) broadcast(event *proto.RoomEvent) {
+	r.seq++
+	event.Seq = r.seq
+	event.Time = time.Now().UnixNano() / int64(time.Millisecond)
+
+	r.history = append(r.history, event)
+	if len(r.history) > roomHistorySize {
+		r.history = r.history[len(r.history)-roomHistorySize:]
+	}
+
+	for _, user := range r.users {
+		if !user.connected {
+			continue // Missed events are replayed on reconnect
+		}
+
+		select {
+		case user.events <- event:
+		default:
+		}
+	}
+
+	if r.onEvent != nil {
+		r.onEvent(event, r.roomInfo())
+	}
+}
+
+// Broadcast a chat message from the user. Users can only send a limited number of messages within the rate window.
+func (r *Room) sendChat(id int, text string) error {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return errNotInRoom
+	}
+
+	if !r.chatLimiter.allow(id) {
+		return errChatRate
+	}
+	now := time.Now()
+
+	// The message carries the sequence number and time of the event it is broadcast with
+	msg := &proto.ChatMessage{
+		Seq:    r.seq + 1,
+		Time:   now.UnixNano() / int64(time.Millisecond),
+		Sender: user.proto(),
+		Text:   text,
+	}
+
+	r.chat = append(r.chat, msg)
+	if len(r.chat) > chatHistorySize {
+		r.chat = r.chat[len(r.chat)-chatHistorySize:]
+	}
+
+	r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_ChatMessage{ChatMessage: msg}})
 	return nil
 }
 
+// Return the recent chat messages.
+func (r *Room) getChat() []*proto.ChatMessage {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	chat := make([]*proto.ChatMessage, len(r.chat))
+	copy(chat, r.chat)
+	return chat
+}
+
+// Close the room, mut must be locked first.
+func (r *Room) close() {
+	r.stopSimulation()
+	r.broadcast(&proto.RoomEvent{Event: &proto.RoomEvent_RoomClosed{RoomClosed: &proto.RoomClosed{}}})
+}
+
 func (r *Room) getUser(id int) *RoomUser {
 	r.mut.Lock()
 	defer r.mut.Unlock()
@@ -56,19 +500,104 @@ func (r *Room) getUser(id int) *RoomUser {
 	return nil
 }
 
+// Return the IDs of the users that are ready.
+func (r *Room) getReady() []int32 {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	ready := make([]int32, 0, len(r.users))
+	for _, user := range r.users {
+		if user.ready {
+			ready = append(ready, int32(user.id))
+		}
+	}
+
+	return ready
+}
+
+// Return the sequence number of the last event and the IDs of the users that are disconnected.
+func (r *Room) getConnections() (int64, []int32) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	disconnected := make([]int32, 0)
+	for _, user := range r.users {
+		if !user.connected {
+			disconnected = append(disconnected, int32(user.id))
+		}
+	}
+
+	return r.seq, disconnected
+}
+
+// Return a copy of every user in the room.
+func (r *Room) getUsers() []RoomUser {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	users := make([]RoomUser, 0, len(r.users))
+	for _, user := range r.users {
+		users = append(users, *user)
+	}
+
+	return users
+}
+
+// Return a summary of the room for room listings.
+func (r *Room) info() *proto.RoomInfo {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	return r.roomInfo()
+}
+
+// Return a summary of the room, mut must be locked first.
+func (r *Room) roomInfo() *proto.RoomInfo {
+	info := &proto.RoomInfo{
+		Id:             r.id,
+		Name:           r.settings.Name,
+		Users:          int32(len(r.users)),
+		Capacity:       int32(r.settings.Capacity),
+		State:          r.state,
+		CreatedAt:      r.createdAt.UnixNano() / int64(time.Millisecond),
+		Public:         r.settings.Public,
+		Tags:           r.settings.Tags,
+		ReadyQuorum:    int32(r.settings.ReadyQuorum),
+		SpectatorDelay: int32(r.settings.SpectatorDelay / time.Second),
+	}
+
+	if host, ok := r.users[r.hostID]; ok {
+		info.Host = host.proto()
+	}
+
+	return info
+}
+
 // RoomUser describes a user in a room.
 type RoomUser struct {
-	id     int
-	name   string
-	joined chan *RoomUser // Channel receives user id when a user joins
-	leave  chan int
+	id          int
+	name        string
+	ready       bool
+	connected   bool
+	joinedAt    time.Time
+	joined      chan *RoomUser // Receives the users that join, buffered so joins before the stream opens are kept
+	leave       chan int
+	events      chan *proto.RoomEvent // Closed when the user is removed from the room or another stream is attached
+	evict       *time.Timer           // Removes the user once the reconnect grace period is over
+	disconnects int                   // Num times the user has disconnected, used to ignore stale evictions
 }
 
 func newRoomUser(id int, name string) *RoomUser {
 	return &RoomUser{
-		id:     id,
-		name:   name,
-		joined: make(chan *RoomUser),
-		leave:  make(chan int),
+		id:        id,
+		name:      name,
+		connected: true,
+		joined:    make(chan *RoomUser, roomEventBuffer),
+		leave:     make(chan int),
+		events:    make(chan *proto.RoomEvent, roomEventBuffer),
 	}
 }
+
+func (ru *RoomUser) proto() *proto.User {
+	return &proto.User{Id: int32(ru.id), Name: ru.name}
+}
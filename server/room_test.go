@@ -0,0 +1,417 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cdrpl/granny/server/proto"
+)
+
+// Return a room with users 1 to n joined in order, user 1 is the host.
+func testRoom(t *testing.T, settings RoomSettings, n int) *Room {
+	if settings.Capacity == 0 {
+		settings.Capacity = roomSize
+	}
+	room := newRoom("room", settings)
+
+	start := time.Now()
+	for id := 1; id <= n; id++ {
+		if err := room.joinRoom(newRoomUser(id, "user")); err != nil {
+			t.Fatalf("joinRoom(%v) error = %v", id, err)
+		}
+		room.users[id].joinedAt = start.Add(time.Duration(id) * time.Second)
+	}
+
+	// Timers left running would start games in later tests
+	t.Cleanup(func() {
+		room.mut.Lock()
+		defer room.mut.Unlock()
+		if room.countdown != nil {
+			room.countdown.Stop()
+		}
+		room.stopSimulation()
+	})
+
+	return room
+}
+
+// Return the events the user has received since the last call.
+func drainEvents(user *RoomUser) []*proto.RoomEvent {
+	var events []*proto.RoomEvent
+	for len(user.events) > 0 {
+		events = append(events, <-user.events)
+	}
+	return events
+}
+
+// Fail the test if the error is not nil.
+func must(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRoomReadyCountdown(t *testing.T) {
+	tests := []struct {
+		name      string
+		quorum    int
+		users     int
+		actions   func(t *testing.T, r *Room)
+		wantState proto.RoomState
+		wantHost  int
+	}{
+		{"nobody ready", 0, 3, func(t *testing.T, r *Room) {}, proto.RoomState_ROOM_STATE_OPEN, 1},
+		{"everyone ready", 0, 3, func(t *testing.T, r *Room) {
+			must(t, r.setReady(1, true))
+			must(t, r.setReady(2, true))
+			must(t, r.setReady(3, true))
+		}, proto.RoomState_ROOM_STATE_COUNTDOWN, 1},
+		{"not everyone ready", 0, 3, func(t *testing.T, r *Room) {
+			must(t, r.setReady(1, true))
+			must(t, r.setReady(2, true))
+		}, proto.RoomState_ROOM_STATE_OPEN, 1},
+		{"quorum ready", 2, 3, func(t *testing.T, r *Room) {
+			must(t, r.setReady(2, true))
+			must(t, r.setReady(3, true))
+		}, proto.RoomState_ROOM_STATE_COUNTDOWN, 1},
+		{"unready cancels", 0, 2, func(t *testing.T, r *Room) {
+			must(t, r.setReady(1, true))
+			must(t, r.setReady(2, true))
+			must(t, r.setReady(2, false))
+		}, proto.RoomState_ROOM_STATE_OPEN, 1},
+		{"ready again restarts", 0, 2, func(t *testing.T, r *Room) {
+			must(t, r.setReady(1, true))
+			must(t, r.setReady(2, true))
+			must(t, r.setReady(2, false))
+			must(t, r.setReady(2, true))
+		}, proto.RoomState_ROOM_STATE_COUNTDOWN, 1},
+		{"quorum lowered to the ready users", 3, 3, func(t *testing.T, r *Room) {
+			must(t, r.setReady(1, true))
+			must(t, r.setReady(2, true))
+			_, err := r.updateSettings(1, RoomSettings{Capacity: roomSize, ReadyQuorum: 2})
+			must(t, err)
+		}, proto.RoomState_ROOM_STATE_COUNTDOWN, 1},
+		{"quorum raised during countdown", 2, 3, func(t *testing.T, r *Room) {
+			must(t, r.setReady(1, true))
+			must(t, r.setReady(2, true))
+			if _, err := r.updateSettings(1, RoomSettings{Capacity: roomSize, ReadyQuorum: 3}); err != errNotOpen {
+				t.Errorf("updateSettings() error = %v, want %v", err, errNotOpen)
+			}
+			if r.settings.ReadyQuorum != 2 {
+				t.Errorf("quorum = %v, want it unchanged", r.settings.ReadyQuorum)
+			}
+		}, proto.RoomState_ROOM_STATE_COUNTDOWN, 1},
+		{"member leaves during countdown", 2, 3, func(t *testing.T, r *Room) {
+			must(t, r.setReady(1, true))
+			must(t, r.setReady(2, true))
+			_, err := r.leaveRoom(3)
+			must(t, err)
+		}, proto.RoomState_ROOM_STATE_OPEN, 1},
+		{"host leaves during countdown", 0, 3, func(t *testing.T, r *Room) {
+			must(t, r.setReady(1, true))
+			must(t, r.setReady(2, true))
+			must(t, r.setReady(3, true))
+			_, err := r.leaveRoom(1)
+			must(t, err)
+		}, proto.RoomState_ROOM_STATE_OPEN, 2},
+		{"host kicks during countdown", 0, 3, func(t *testing.T, r *Room) {
+			must(t, r.setReady(1, true))
+			must(t, r.setReady(2, true))
+			must(t, r.setReady(3, true))
+			must(t, r.kickUser(1, 3))
+		}, proto.RoomState_ROOM_STATE_OPEN, 1},
+		{"last unready member leaves", 0, 3, func(t *testing.T, r *Room) {
+			must(t, r.setReady(1, true))
+			must(t, r.setReady(2, true))
+			_, err := r.leaveRoom(3)
+			must(t, err)
+		}, proto.RoomState_ROOM_STATE_COUNTDOWN, 1},
+		{"unready host leaves", 0, 3, func(t *testing.T, r *Room) {
+			must(t, r.setReady(2, true))
+			must(t, r.setReady(3, true))
+			_, err := r.leaveRoom(1)
+			must(t, err)
+		}, proto.RoomState_ROOM_STATE_COUNTDOWN, 2},
+		{"join during countdown", 0, 2, func(t *testing.T, r *Room) {
+			must(t, r.setReady(1, true))
+			must(t, r.setReady(2, true))
+			if err := r.joinRoom(newRoomUser(3, "user")); err != errNotOpen {
+				t.Errorf("joinRoom() error = %v, want %v", err, errNotOpen)
+			}
+		}, proto.RoomState_ROOM_STATE_COUNTDOWN, 1},
+		{"host starts", 0, 3, func(t *testing.T, r *Room) {
+			if err := r.startGame(2); err != errNotHost {
+				t.Errorf("startGame() by a member error = %v, want %v", err, errNotHost)
+			}
+			must(t, r.startGame(1))
+		}, proto.RoomState_ROOM_STATE_COUNTDOWN, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			room := testRoom(t, RoomSettings{ReadyQuorum: tt.quorum}, tt.users)
+			tt.actions(t, room)
+
+			if room.state != tt.wantState {
+				t.Errorf("state = %v, want %v", room.state, tt.wantState)
+			}
+			if (room.countdown != nil) != (tt.wantState == proto.RoomState_ROOM_STATE_COUNTDOWN) {
+				t.Errorf("countdown timer set = %v in state %v", room.countdown != nil, room.state)
+			}
+			if room.hostID != tt.wantHost {
+				t.Errorf("host = %v, want %v", room.hostID, tt.wantHost)
+			}
+		})
+	}
+}
+
+func TestRoomCountdownEnds(t *testing.T) {
+	room := testRoom(t, RoomSettings{}, 2)
+	must(t, room.setReady(1, true))
+	must(t, room.setReady(2, true))
+	cancelled := room.countdown
+
+	// A countdown that was cancelled while its timer was firing does not start the game
+	must(t, room.setReady(2, false))
+	must(t, room.setReady(2, true))
+	room.endCountdown(cancelled)
+	if room.state != proto.RoomState_ROOM_STATE_COUNTDOWN {
+		t.Fatalf("state = %v after a cancelled countdown ended, want countdown", room.state)
+	}
+
+	drainEvents(room.users[1])
+	room.endCountdown(room.countdown)
+
+	if room.state != proto.RoomState_ROOM_STATE_IN_PROGRESS || room.countdown != nil || room.sim == nil {
+		t.Errorf("state, countdown, sim = %v, %v, %v, want a game in progress", room.state, room.countdown, room.sim)
+	}
+	if events := drainEvents(room.users[1]); len(events) != 1 || events[0].GetGameStarted() == nil {
+		t.Errorf("events = %v, want game started", events)
+	}
+	if err := room.setReady(1, false); err != errNotOpen {
+		t.Errorf("setReady() in game error = %v, want %v", err, errNotOpen)
+	}
+}
+
+func TestRoomHostMigration(t *testing.T) {
+	tests := []struct {
+		name     string
+		joinedAt map[int]int // User ID to seconds after the room was created, users not listed join in ID order
+		leave    []int
+		wantHost int
+	}{
+		{"member leaves", nil, []int{3}, 1},
+		{"host leaves", nil, []int{1}, 2},
+		{"longest present", map[int]int{2: 10, 3: 5, 4: 7}, []int{1}, 3},
+		{"tie goes to lowest ID", map[int]int{2: 5, 3: 5, 4: 5}, []int{1}, 2},
+		{"hosts leave in turn", nil, []int{1, 2, 3}, 4},
+		{"last user leaves", nil, []int{2, 3, 4, 1}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			room := testRoom(t, RoomSettings{}, 4)
+			for id, seconds := range tt.joinedAt {
+				room.users[id].joinedAt = room.createdAt.Add(time.Duration(seconds) * time.Second)
+			}
+
+			for _, id := range tt.leave {
+				host := room.hostID
+				_, err := room.leaveRoom(id)
+				must(t, err)
+
+				// Every remaining user is told about the new host
+				for _, user := range room.users {
+					changed := false
+					for _, event := range drainEvents(user) {
+						if event.GetHostChanged() != nil {
+							changed = int(event.GetHostChanged().Id) == room.hostID
+						}
+					}
+					if changed != (id == host) {
+						t.Errorf("user %v told the host changed = %v after user %v left", user.id, changed, id)
+					}
+				}
+			}
+
+			if room.hostID != tt.wantHost {
+				t.Errorf("host = %v, want %v", room.hostID, tt.wantHost)
+			}
+		})
+	}
+}
+
+// Joins are kept for the UserJoined stream even when it is not being read at the time of the join.
+func TestRoomUserJoinedBuffered(t *testing.T) {
+	room := newRoom("room", RoomSettings{Capacity: 4})
+	first := newRoomUser(1, "user")
+
+	if err := room.joinRoom(first); err != nil {
+		t.Fatalf("joinRoom() error = %v", err)
+	}
+	if err := room.joinUsers([]*RoomUser{newRoomUser(2, "user"), newRoomUser(3, "user")}); err != nil {
+		t.Fatalf("joinUsers() error = %v", err)
+	}
+
+	var got []int
+	for len(first.joined) > 0 {
+		got = append(got, (<-first.joined).id)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("joined = %v, want %v", got, want)
+	}
+}
+
+func TestRoomReconnect(t *testing.T) {
+	noEvict := func(int) {}
+
+	// Attach a stream for the user and drop it straight away
+	drop := func(t *testing.T, r *Room, id int) {
+		events, _, err := r.attach(id, -1)
+		must(t, err)
+		r.disconnect(id, events, noEvict)
+	}
+
+	tests := []struct {
+		name          string
+		quorum        int
+		user          int // User the checks are made on
+		actions       func(t *testing.T, r *Room)
+		wantInRoom    bool
+		wantConnected bool
+		wantHost      int
+		wantState     proto.RoomState
+	}{
+		{"disconnected", 0, 1, func(t *testing.T, r *Room) {
+			drop(t, r, 1)
+		}, true, false, 1, proto.RoomState_ROOM_STATE_OPEN},
+		{"reconnect inside window", 0, 1, func(t *testing.T, r *Room) {
+			drop(t, r, 1)
+			_, _, err := r.attach(1, -1)
+			must(t, err)
+			if r.users[1].evict != nil {
+				t.Error("eviction still scheduled after reconnecting")
+			}
+			if _, err := r.evictUser(1, 1); err == nil {
+				t.Error("evictUser() removed a user that reconnected")
+			}
+		}, true, true, 1, proto.RoomState_ROOM_STATE_OPEN},
+		{"window ends", 0, 2, func(t *testing.T, r *Room) {
+			drop(t, r, 2)
+			_, err := r.evictUser(2, 1)
+			must(t, err)
+		}, false, false, 1, proto.RoomState_ROOM_STATE_OPEN},
+		{"host window ends", 0, 1, func(t *testing.T, r *Room) {
+			drop(t, r, 1)
+			_, err := r.evictUser(1, 1)
+			must(t, err)
+		}, false, false, 2, proto.RoomState_ROOM_STATE_OPEN},
+		{"reconnect after window", 0, 1, func(t *testing.T, r *Room) {
+			drop(t, r, 1)
+			_, err := r.evictUser(1, 1)
+			must(t, err)
+			if _, _, err := r.attach(1, -1); err != errNotInRoom {
+				t.Errorf("attach() after eviction error = %v, want %v", err, errNotInRoom)
+			}
+		}, false, false, 2, proto.RoomState_ROOM_STATE_OPEN},
+		{"eviction from an earlier disconnect", 0, 1, func(t *testing.T, r *Room) {
+			drop(t, r, 1)
+			_, _, err := r.attach(1, -1)
+			must(t, err)
+			drop(t, r, 1)
+			if _, err := r.evictUser(1, 1); err == nil {
+				t.Error("evictUser() removed the user for an earlier disconnect")
+			}
+		}, true, false, 1, proto.RoomState_ROOM_STATE_OPEN},
+		{"replaced stream ends", 0, 1, func(t *testing.T, r *Room) {
+			old, _, err := r.attach(1, -1)
+			must(t, err)
+			_, _, err = r.attach(1, -1)
+			must(t, err)
+			r.disconnect(1, old, noEvict)
+		}, true, true, 1, proto.RoomState_ROOM_STATE_OPEN},
+		{"disconnected users keep the countdown", 0, 3, func(t *testing.T, r *Room) {
+			must(t, r.setReady(1, true))
+			must(t, r.setReady(2, true))
+			must(t, r.setReady(3, true))
+			drop(t, r, 3)
+		}, true, false, 1, proto.RoomState_ROOM_STATE_COUNTDOWN},
+		{"window ends during countdown", 2, 1, func(t *testing.T, r *Room) {
+			must(t, r.setReady(2, true))
+			must(t, r.setReady(3, true))
+			drop(t, r, 1)
+			_, err := r.evictUser(1, 1)
+			must(t, err)
+		}, false, false, 2, proto.RoomState_ROOM_STATE_OPEN},
+		{"window ends for the only unready user", 0, 1, func(t *testing.T, r *Room) {
+			must(t, r.setReady(2, true))
+			must(t, r.setReady(3, true))
+			drop(t, r, 1)
+			_, err := r.evictUser(1, 1)
+			must(t, err)
+		}, false, false, 2, proto.RoomState_ROOM_STATE_COUNTDOWN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			room := testRoom(t, RoomSettings{ReadyQuorum: tt.quorum}, 3)
+			tt.actions(t, room)
+
+			user, ok := room.users[tt.user]
+			if ok != tt.wantInRoom {
+				t.Errorf("user in room = %v, want %v", ok, tt.wantInRoom)
+			} else if ok && user.connected != tt.wantConnected {
+				t.Errorf("connected = %v, want %v", user.connected, tt.wantConnected)
+			}
+			if room.hostID != tt.wantHost {
+				t.Errorf("host = %v, want %v", room.hostID, tt.wantHost)
+			}
+			if room.state != tt.wantState {
+				t.Errorf("state = %v, want %v", room.state, tt.wantState)
+			}
+		})
+	}
+}
+
+func TestRoomResumeReplay(t *testing.T) {
+	room := testRoom(t, RoomSettings{}, 2)
+
+	// Fill the history past its size, toggling ready broadcasts an event every time
+	for i := 0; i < roomHistorySize+10; i++ {
+		must(t, room.setReady(2, i%2 == 0))
+	}
+	seq := room.seq
+
+	tests := []struct {
+		name       string
+		since      int64
+		wantEvents int
+		wantError  bool
+	}{
+		{"no replay", -1, 0, false},
+		{"up to date", seq, 0, false},
+		{"missed some", seq - 3, 3, false},
+		{"oldest kept", seq - roomHistorySize, roomHistorySize, false},
+		{"older than history", seq - roomHistorySize - 1, 0, true},
+		{"from the start", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, replay, err := room.attach(1, tt.since)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("attach() error = %v, want error %v", err, tt.wantError)
+			}
+			if len(replay) != tt.wantEvents {
+				t.Fatalf("replayed %v events, want %v", len(replay), tt.wantEvents)
+			}
+			for i, event := range replay {
+				if want := tt.since + int64(i) + 1; event.Seq != want {
+					t.Errorf("replay[%v] seq = %v, want %v", i, event.Seq, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"io"
+	"log"
+
+	"github.com/cdrpl/granny/server/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys added to requests that one instance forwards to another.
+const (
+	forwardedKey     = "forwarded-by"   // ID of the instance that forwarded the request
+	forwardSecretKey = "forward-secret" // Secret shared by every instance
+)
+
+// Return the room if this instance owns it, otherwise return a client for the instance that owns it.
+// A NotFound error is returned if the room does not exist or its owner is no longer running.
+func (s *Server) routeRoom(ctx context.Context, roomID string) (*Room, proto.RoomClient, error) {
+	if room := s.lobby.getRoom(roomID); room != nil {
+		return room, nil, nil
+	}
+
+	// Forwarded requests are never forwarded again, the directory is out of date
+	if isForwarded(ctx) {
+		return nil, nil, status.Error(codes.NotFound, "room not found")
+	}
+
+	instanceID, err := s.dir.owner(roomID)
+	if err != nil {
+		log.Println("room owner error:", err)
+		return nil, nil, status.Error(codes.Internal, "route room error")
+	} else if instanceID == "" || instanceID == s.dir.instanceID {
+		return nil, nil, status.Error(codes.NotFound, "room not found")
+	}
+
+	client, err := s.dir.client(instanceID)
+	if err != nil {
+		log.Println("room client error:", err)
+		return nil, nil, status.Error(codes.Internal, "route room error")
+	} else if client == nil {
+		return nil, nil, status.Error(codes.NotFound, "room not found")
+	}
+
+	return nil, client, nil
+}
+
+// Same as routeRoom but for the room the user is in.
+// A FailedPrecondition error is returned if the user is not in a room.
+func (s *Server) routeUserRoom(ctx context.Context, userID int) (*Room, proto.RoomClient, error) {
+	if room := s.lobby.getUserRoom(userID); room != nil {
+		return room, nil, nil
+	}
+
+	notInRoom := status.Error(codes.FailedPrecondition, "user is not in a room")
+	if isForwarded(ctx) {
+		return nil, nil, notInRoom
+	}
+
+	roomID, err := s.dir.userRoom(userID)
+	if err != nil {
+		log.Println("user room error:", err)
+		return nil, nil, status.Error(codes.Internal, "route room error")
+	} else if roomID == "" {
+		return nil, nil, notInRoom
+	}
+
+	room, client, err := s.routeRoom(ctx, roomID)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil, notInRoom
+	}
+
+	return room, client, err
+}
+
+// Create an outgoing context for forwarding the request to another instance.
+// The user's credentials are passed along so the other instance can authenticate the request.
+func (s *Server) forwardContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	out := metadata.Pairs(forwardedKey, s.dir.instanceID, forwardSecretKey, s.dir.secret)
+	out.Set("user-id", md.Get("user-id")...)
+	out.Set("token", md.Get("token")...)
+
+	return metadata.NewOutgoingContext(ctx, out)
+}
+
+// Check if the request was forwarded by another instance. Any client can set the metadata key, so this must only be
+// used to stop requests being forwarded again and never to skip a check.
+func isForwarded(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(forwardedKey)) > 0
+}
+
+// Check if the request was forwarded by another instance and carries the secret shared by every instance.
+// Unlike isForwarded this can not be faked by a client.
+func (d *Directory) forwardedByInstance(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(forwardedKey)) == 0 {
+		return false
+	}
+
+	secret := md.Get(forwardSecretKey)
+	return len(secret) == 1 && d.secret != "" && subtle.ConstantTimeCompare([]byte(secret[0]), []byte(d.secret)) == 1
+}
+
+// roomEventClient is a client stream that receives room events.
+type roomEventClient interface {
+	Recv() (*proto.RoomEvent, error)
+}
+
+// Relay room events from the instance that owns the room to the user.
+func relayRoomEvents(upstream roomEventClient, stream roomEventStream) error {
+	for {
+		event, err := upstream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+}
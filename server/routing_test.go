@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestForwardedByInstance(t *testing.T) {
+	dir := &Directory{instanceID: "a", secret: "secret"}
+
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want bool
+	}{
+		{"not forwarded", metadata.Pairs("user-id", "1"), false},
+		{"forwarded by instance", metadata.Pairs(forwardedKey, "b", forwardSecretKey, "secret"), true},
+		{"forwarded without secret", metadata.Pairs(forwardedKey, "b"), false},
+		{"wrong secret", metadata.Pairs(forwardedKey, "b", forwardSecretKey, "guess"), false},
+		{"secret without forwarded", metadata.Pairs(forwardSecretKey, "secret"), false},
+		{"several secrets", metadata.Pairs(forwardedKey, "b", forwardSecretKey, "guess", forwardSecretKey, "secret"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			if got := dir.forwardedByInstance(ctx); got != tt.want {
+				t.Errorf("forwardedByInstance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Requests are never trusted before the secret is loaded
+	empty := &Directory{instanceID: "a"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(forwardedKey, "b", forwardSecretKey, ""))
+	if empty.forwardedByInstance(ctx) {
+		t.Error("forwardedByInstance() = true with no secret loaded")
+	}
+}
@@ -2,9 +2,15 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"net"
+	"os"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/cdrpl/granny/server/proto"
@@ -19,22 +25,67 @@ import (
 
 // Server handles GRPC requests.
 type Server struct {
-	pg   *pgxpool.Pool
-	rdb  *redis.Client
-	room Room
+	pg         *pgxpool.Pool
+	rdb        *redis.Client
+	dir        *Directory
+	lobby      Lobby
+	chat       *ChatHub
+	social     *SocialHub
+	parties    *Parties
+	chatFilter TextFilter
+	nameFilter TextFilter
+	world      *TileMap
 	proto.UnimplementedAuthServer
 	proto.UnimplementedRoomServer
+	proto.UnimplementedChatServer
+	proto.UnimplementedSocialServer
+	proto.UnimplementedPartyServer
+	proto.UnimplementedGuildServer
+	proto.UnimplementedWorldServer
 }
 
 // Create new GRPC server.
 func createServer(pg *pgxpool.Pool, rdb *redis.Client) *Server {
-	return &Server{pg: pg, rdb: rdb, room: newRoom()}
+	dir := newDirectory(rdb, os.Getenv("INSTANCE_ID"), os.Getenv("INSTANCE_ADDR"))
+	if err := dir.loadSecret(); err != nil {
+		log.Fatalln("load instance secret error:", err)
+	}
+
+	chatFilter, nameFilter, err := createFilters()
+	if err != nil {
+		log.Fatalln("load chat filters error:", err)
+	}
+
+	tickRate, err := strconv.Atoi(os.Getenv("TICK_RATE"))
+	if err != nil || tickRate < 1 || tickRate > maxTickRate {
+		log.Fatalf("TICK_RATE must be between 1 and %d\n", maxTickRate)
+	}
+
+	world, err := loadWorld(dir)
+	if err != nil {
+		log.Fatalln("load world map error:", err)
+	}
+
+	social := newSocialHub(rdb, pg, dir)
+
+	return &Server{
+		pg:         pg,
+		rdb:        rdb,
+		dir:        dir,
+		lobby:      newLobby(dir, tickRate, world),
+		chat:       newChatHub(rdb, pg, social),
+		social:     social,
+		parties:    newParties(rdb),
+		chatFilter: chatFilter,
+		nameFilter: nameFilter,
+		world:      world,
+	}
 }
 
 // SignUp is used for new user registrations
 func (s *Server) SignUp(ctx context.Context, in *proto.SignUpRequest) (*proto.SignUpResponse, error) {
 	// Input validation
-	err := validateSignUpRequest(in)
+	err := validateSignUpRequest(in, s.nameFilter)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -109,9 +160,10 @@ func (s *Server) SignIn(ctx context.Context, in *proto.SignInRequest) (*proto.Si
 
 	// Create sign in response
 	res := &proto.SignInResponse{
-		Id:    int32(user.ID),
-		Name:  user.Name,
-		Token: token,
+		Id:             int32(user.ID),
+		Name:           user.Name,
+		Token:          token,
+		UnreadMessages: s.unreadMessages(user.ID),
 	}
 
 	return res, nil
@@ -119,18 +171,48 @@ func (s *Server) SignIn(ctx context.Context, in *proto.SignInRequest) (*proto.Si
 
 // GetRoom will return a map of users in the room.
 func (s *Server) GetRoom(ctx context.Context, in *proto.GetRoomRequest) (*proto.GetRoomResponse, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	var room *Room
+	var client proto.RoomClient
+	var err error
+	if in.Id == "" {
+		room, client, err = s.routeUserRoom(ctx, id)
+	} else {
+		room, client, err = s.routeRoom(ctx, in.Id)
+	}
+
+	if err != nil {
+		return nil, err
+	} else if client != nil {
+		return client.GetRoom(s.forwardContext(ctx), in)
+	}
+
+	seq, disconnected := room.getConnections()
+
 	res := &proto.GetRoomResponse{
-		Users: make(map[int32]*proto.User),
+		Users:        make(map[int32]*proto.User),
+		Room:         room.info(),
+		Ready:        room.getReady(),
+		Seq:          seq,
+		Disconnected: disconnected,
+		ChatHistory:  s.visibleChat(id, room.getChat()),
 	}
 
-	for _, user := range s.room.users {
+	res.Room.Spectators, err = s.dir.spectators(room.id)
+	if err != nil {
+		log.Println("get room spectators error:", err)
+	}
+
+	for _, user := range room.getUsers() {
 		res.Users[int32(user.id)] = &proto.User{Id: int32(user.id), Name: user.name}
 	}
 
 	return res, nil
 }
 
-// JoinRoom will allow a user to join a room.
+// JoinRoom will allow a user to join a room. If no room ID is given the user joins the oldest open public room,
+// a new public room is created if there are none. A party leader joins with their whole party or not at all.
 func (s *Server) JoinRoom(ctx context.Context, in *proto.JoinRoomReq) (*proto.JoinRoomRes, error) {
 	id, _, _ := extractUserIDAndToken(ctx)
 
@@ -139,21 +221,91 @@ func (s *Server) JoinRoom(ctx context.Context, in *proto.JoinRoomReq) (*proto.Jo
 		return nil, status.Errorf(codes.Internal, "join room error: %v", err)
 	}
 
-	ru := newRoomUser(id, user.Name)
+	group, party, err := s.roomGroup(id, user.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find an open room on any instance
+	if in.Id == "" {
+		infos, err := s.dir.roomInfos()
+		if err != nil {
+			log.Println("join room list error:", err)
+			return nil, status.Error(codes.Internal, "join room error")
+		}
 
-	err = s.room.joinRoom(ru)
+		// Users are not paired with users they have blocked or have been blocked by
+		exclude := make(map[string]bool)
+		for _, member := range group {
+			rooms, err := s.blockedRooms(int(member.Id))
+			if err != nil {
+				log.Println("join room blocked rooms error:", err)
+				return nil, status.Error(codes.Internal, "join room error")
+			}
+			for roomID := range rooms {
+				exclude[roomID] = true
+			}
+		}
+
+		if info := findOpenRoom(infos, len(group), exclude); info != nil {
+			in.Id = info.Id
+		}
+	}
+
+	if in.Id != "" {
+		_, client, err := s.routeRoom(ctx, in.Id)
+		if err != nil {
+			return nil, err
+		} else if client != nil {
+			return client.JoinRoom(s.forwardContext(ctx), in)
+		}
+	}
+
+	roomID := in.Id
+	created := false
+	if roomID == "" {
+		room, err := s.lobby.createRoom(RoomSettings{Name: user.Name, Capacity: roomSize, Public: true})
+		if err != nil {
+			log.Println(err)
+			return nil, status.Error(codes.Internal, "create room error")
+		}
+		roomID = room.id
+		created = true
+	}
+
+	users := make([]*RoomUser, len(group))
+	for i, member := range group {
+		users[i] = newRoomUser(int(member.Id), member.Name)
+	}
+
+	room, err := s.lobby.joinUsers(roomID, users)
 	if err != nil {
+		if created {
+			s.lobby.removeRoom(roomID)
+		}
 		return nil, status.Errorf(codes.FailedPrecondition, "join room error: %v", err)
 	}
 
-	return &proto.JoinRoomRes{}, nil
+	if party != nil {
+		event := &proto.PartyEvent{Event: &proto.PartyEvent_JoinedRoom{JoinedRoom: roomID}}
+		if err := s.parties.publish(party, event); err != nil {
+			log.Println("publish party joined room error:", err)
+		}
+	}
+
+	return &proto.JoinRoomRes{ChatHistory: s.visibleChat(id, room.getChat())}, nil
 }
 
 // UserJoined streams a user whenever a user joins the room.
 func (s *Server) UserJoined(req *proto.UserJoinedReq, stream proto.Room_UserJoinedServer) error {
 	id, _, _ := extractUserIDAndToken(stream.Context())
 
-	ru := s.room.getUser(id)
+	room := s.lobby.getUserRoom(id)
+	if room == nil {
+		return status.Error(codes.FailedPrecondition, "user is not in a room")
+	}
+
+	ru := room.getUser(id)
 
 	for {
 		select {
@@ -168,6 +320,465 @@ func (s *Server) UserJoined(req *proto.UserJoinedReq, stream proto.Room_UserJoin
 	}
 }
 
+// CreateRoom will create a new room on this instance with the user as the host. A party leader creates the room
+// with their whole party in it.
+func (s *Server) CreateRoom(ctx context.Context, in *proto.CreateRoomReq) (*proto.CreateRoomRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	err := validateCreateRoomRequest(in, s.nameFilter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := findUser(id, s.pg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "create room error: %v", err)
+	}
+
+	group, party, err := s.roomGroup(id, user.Name)
+	if err != nil {
+		return nil, err
+	} else if int(in.Capacity) < len(group) {
+		return nil, status.Error(codes.FailedPrecondition, "room capacity is less than the party size")
+	}
+
+	settings := RoomSettings{
+		Name:           in.Name,
+		Capacity:       int(in.Capacity),
+		Public:         in.Public,
+		Tags:           in.Tags,
+		ReadyQuorum:    int(in.ReadyQuorum),
+		SpectatorDelay: time.Duration(in.SpectatorDelay) * time.Second,
+	}
+
+	room, err := s.lobby.createRoom(settings)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "create room error")
+	}
+
+	users := make([]*RoomUser, len(group))
+	for i, member := range group {
+		users[i] = newRoomUser(int(member.Id), member.Name)
+	}
+
+	if _, err := s.lobby.joinUsers(room.id, users); err != nil {
+		s.lobby.removeRoom(room.id)
+		return nil, status.Errorf(codes.FailedPrecondition, "create room error: %v", err)
+	}
+
+	if party != nil {
+		event := &proto.PartyEvent{Event: &proto.PartyEvent_JoinedRoom{JoinedRoom: room.id}}
+		if err := s.parties.publish(party, event); err != nil {
+			log.Println("publish party joined room error:", err)
+		}
+	}
+
+	return &proto.CreateRoomRes{Room: room.info()}, nil
+}
+
+// ListRooms will return a page of public rooms on every instance that match the filters.
+func (s *Server) ListRooms(ctx context.Context, in *proto.ListRoomsReq) (*proto.ListRoomsRes, error) {
+	limit := roomPageLimit(in.Limit)
+
+	filter := RoomFilter{
+		NotFull: in.NotFull,
+		States:  in.States,
+		Tag:     strings.ToLower(govalidator.Trim(in.Tag, "")),
+	}
+
+	infos, err := s.dir.roomInfos()
+	if err != nil {
+		log.Println("list rooms error:", err)
+		return nil, status.Error(codes.Internal, "list rooms error")
+	}
+
+	rooms, next, err := listRooms(infos, filter, in.Cursor, limit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &proto.ListRoomsRes{Rooms: rooms, NextCursor: next}, nil
+}
+
+// LeaveRoom will remove the user from their room.
+func (s *Server) LeaveRoom(ctx context.Context, in *proto.LeaveRoomReq) (*proto.LeaveRoomRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	_, client, err := s.routeUserRoom(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if client != nil {
+		return client.LeaveRoom(s.forwardContext(ctx), in)
+	}
+
+	if err := s.lobby.leaveRoom(id); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "leave room error: %v", err)
+	}
+
+	return &proto.LeaveRoomRes{}, nil
+}
+
+// KickUser allows the host to remove a user from the room.
+func (s *Server) KickUser(ctx context.Context, in *proto.KickUserReq) (*proto.KickUserRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	_, client, err := s.routeUserRoom(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if client != nil {
+		return client.KickUser(s.forwardContext(ctx), in)
+	}
+
+	if err := s.lobby.kickUser(id, int(in.UserId)); err != nil {
+		return nil, roomError("kick user error", err)
+	}
+
+	return &proto.KickUserRes{}, nil
+}
+
+// UpdateRoom allows the host to change the room settings.
+func (s *Server) UpdateRoom(ctx context.Context, in *proto.UpdateRoomReq) (*proto.UpdateRoomRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	createReq := &proto.CreateRoomReq{
+		Name:           in.Name,
+		Capacity:       in.Capacity,
+		Public:         in.Public,
+		Tags:           in.Tags,
+		ReadyQuorum:    in.ReadyQuorum,
+		SpectatorDelay: in.SpectatorDelay,
+	}
+	if err := validateCreateRoomRequest(createReq, s.nameFilter); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	room, client, err := s.routeUserRoom(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if client != nil {
+		return client.UpdateRoom(s.forwardContext(ctx), in)
+	}
+
+	settings := RoomSettings{
+		Name:           createReq.Name,
+		Capacity:       int(createReq.Capacity),
+		Public:         createReq.Public,
+		Tags:           createReq.Tags,
+		ReadyQuorum:    int(createReq.ReadyQuorum),
+		SpectatorDelay: time.Duration(createReq.SpectatorDelay) * time.Second,
+	}
+
+	info, err := room.updateSettings(id, settings)
+	if err != nil {
+		return nil, roomError("update room error", err)
+	}
+
+	return &proto.UpdateRoomRes{Room: info}, nil
+}
+
+// StartGame allows the host to start the game.
+func (s *Server) StartGame(ctx context.Context, in *proto.StartGameReq) (*proto.StartGameRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	room, client, err := s.routeUserRoom(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if client != nil {
+		return client.StartGame(s.forwardContext(ctx), in)
+	}
+
+	if err := room.startGame(id); err != nil {
+		return nil, roomError("start game error", err)
+	}
+
+	return &proto.StartGameRes{}, nil
+}
+
+// SetReady will change whether the user is ready for the game to start.
+func (s *Server) SetReady(ctx context.Context, in *proto.SetReadyReq) (*proto.SetReadyRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	room, client, err := s.routeUserRoom(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if client != nil {
+		return client.SetReady(s.forwardContext(ctx), in)
+	}
+
+	if err := room.setReady(id, in.Ready); err != nil {
+		return nil, roomError("set ready error", err)
+	}
+
+	return &proto.SetReadyRes{}, nil
+}
+
+// RoomEvents streams every event in the user's room. If the stream ends the user is marked as disconnected
+// and keeps their slot in the room until the reconnect grace period is over.
+func (s *Server) RoomEvents(req *proto.RoomEventsReq, stream proto.Room_RoomEventsServer) error {
+	id, _, _ := extractUserIDAndToken(stream.Context())
+
+	_, client, err := s.routeUserRoom(stream.Context(), id)
+	if err != nil {
+		return err
+	} else if client != nil {
+		upstream, err := client.RoomEvents(s.forwardContext(stream.Context()), req)
+		if err != nil {
+			return err
+		}
+		return relayRoomEvents(upstream, stream)
+	}
+
+	return s.streamRoomEvents(-1, stream)
+}
+
+// ResumeRoom re-attaches a dropped room event stream and replays the events that were missed.
+func (s *Server) ResumeRoom(req *proto.ResumeRoomReq, stream proto.Room_ResumeRoomServer) error {
+	id, _, _ := extractUserIDAndToken(stream.Context())
+
+	if req.SinceSeq < 0 {
+		return status.Error(codes.InvalidArgument, "since seq must not be negative")
+	}
+
+	_, client, err := s.routeUserRoom(stream.Context(), id)
+	if err != nil {
+		return err
+	} else if client != nil {
+		upstream, err := client.ResumeRoom(s.forwardContext(stream.Context()), req)
+		if err != nil {
+			return err
+		}
+		return relayRoomEvents(upstream, stream)
+	}
+
+	return s.streamRoomEvents(req.SinceSeq, stream)
+}
+
+// roomEventStream is a server stream that sends room events.
+type roomEventStream interface {
+	Send(*proto.RoomEvent) error
+	Context() context.Context
+}
+
+// Shared code between the room event streams, events after the since sequence number are replayed first.
+func (s *Server) streamRoomEvents(since int64, stream roomEventStream) error {
+	id, _, _ := extractUserIDAndToken(stream.Context())
+
+	room := s.lobby.getUserRoom(id)
+	if room == nil {
+		return status.Error(codes.FailedPrecondition, "user is not in a room")
+	}
+
+	events, replay, err := room.attach(id, since)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "room events error: %v", err)
+	}
+
+	for _, event := range replay {
+		if s.hideRoomEvent(id, event) {
+			continue
+		}
+		if err := stream.Send(event); err != nil {
+			s.lobby.disconnect(id, events)
+			return err
+		}
+	}
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return nil // User was removed from the room or another stream was attached
+			}
+			if s.hideRoomEvent(id, event) {
+				continue
+			}
+			if err := stream.Send(event); err != nil {
+				s.lobby.disconnect(id, events)
+				return err
+			}
+
+		case <-stream.Context().Done():
+			s.lobby.disconnect(id, events)
+			return nil
+		}
+	}
+}
+
+// SpectateRoom streams the events of a room on any instance without taking a player slot.
+// Events are held back by the room's spectator delay so spectators cannot feed information to the players.
+func (s *Server) SpectateRoom(req *proto.SpectateRoomReq, stream proto.Room_SpectateRoomServer) error {
+	id, _, _ := extractUserIDAndToken(stream.Context())
+
+	info, err := s.dir.roomInfo(req.Id)
+	if err != nil {
+		log.Println("spectate room info error:", err)
+		return status.Error(codes.Internal, "spectate room error")
+	} else if info == nil {
+		return status.Error(codes.NotFound, "room not found")
+	}
+
+	ok, err := s.dir.addSpectator(req.Id)
+	if err != nil {
+		log.Println("add spectator error:", err)
+		return status.Error(codes.Internal, "spectate room error")
+	} else if !ok {
+		return status.Error(codes.FailedPrecondition, "room has too many spectators")
+	}
+	defer s.dir.removeSpectator(req.Id)
+
+	events, err := s.dir.subscribe(req.Id)
+	if err != nil {
+		log.Println("subscribe room error:", err)
+		return status.Error(codes.Internal, "spectate room error")
+	}
+	defer s.dir.unsubscribe(req.Id, events)
+
+	// Check the room still exists after subscribing so the room closed event cannot be missed
+	if owner, err := s.dir.owner(req.Id); err != nil || owner == "" {
+		return status.Error(codes.NotFound, "room not found")
+	}
+
+	delay := time.Duration(info.SpectatorDelay) * time.Second
+
+	// Events are read as they arrive and queued until their delay has passed, so a long delay does not fill the
+	// subscription buffer and drop events
+	type delayedEvent struct {
+		event  *proto.RoomEvent
+		sendAt time.Time
+	}
+	var queue []delayedEvent
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		// Only wait on the timer while it is set for the first queued event
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+
+		var wait <-chan time.Time
+		if len(queue) > 0 {
+			timer.Reset(time.Until(queue[0].sendAt))
+			wait = timer.C
+		} else if events == nil {
+			return nil // Room has closed and every event was sent
+		}
+
+		select {
+		case event, ok := <-events:
+			if !ok {
+				events = nil // Room has closed, send the queued events first
+				continue
+			}
+
+			if settings := event.GetSettingsChanged(); settings != nil {
+				delay = time.Duration(settings.SpectatorDelay) * time.Second
+			} else if s.hideRoomEvent(id, event) {
+				continue
+			}
+
+			if len(queue) >= spectatorQueueSize {
+				continue // Spectator is not keeping up
+			}
+			sendAt := time.Unix(0, event.Time*int64(time.Millisecond)).Add(delay)
+			queue = append(queue, delayedEvent{event: event, sendAt: sendAt})
+
+		case <-wait:
+			for len(queue) > 0 && !time.Now().Before(queue[0].sendAt) {
+				if err := stream.Send(queue[0].event); err != nil {
+					return err
+				}
+				queue[0] = delayedEvent{}
+				queue = queue[1:]
+			}
+
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// SendChat will send a chat message to everyone in the user's room.
+func (s *Server) SendChat(ctx context.Context, in *proto.SendChatReq) (*proto.SendChatRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	if err := validateSendChatRequest(in); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	room, client, err := s.routeUserRoom(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if client != nil {
+		return client.SendChat(s.forwardContext(ctx), in)
+	}
+
+	// Checked by the instance that owns the room, so forwarded messages can not skip the filters
+	user, err := findUser(id, s.pg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "send chat error: %v", err)
+	} else if user.isMuted() {
+		return nil, status.Error(codes.PermissionDenied, "you are muted")
+	}
+
+	if in.Text, err = s.filterChat(id, in.Text); err != nil {
+		return nil, err
+	}
+
+	if err := room.sendChat(id, in.Text); err != nil {
+		return nil, roomError("send chat error", err)
+	}
+
+	return &proto.SendChatRes{}, nil
+}
+
+// SendInput queues a movement input for the user's entity, it is applied on the next simulation tick.
+func (s *Server) SendInput(ctx context.Context, in *proto.SendInputReq) (*proto.SendInputRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	input, err := validateSendInputRequest(in)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	room, client, err := s.routeUserRoom(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if client != nil {
+		return client.SendInput(s.forwardContext(ctx), in)
+	}
+
+	if err := room.queueInput(id, input, in.AckTick); err != nil {
+		return nil, roomError("send input error", err)
+	}
+
+	return &proto.SendInputRes{}, nil
+}
+
+// AckSnapshot acknowledges a snapshot so later snapshots are deltas against it. Inputs also acknowledge snapshots,
+// this is for players that have no input to send.
+func (s *Server) AckSnapshot(ctx context.Context, in *proto.AckSnapshotReq) (*proto.AckSnapshotRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	room, client, err := s.routeUserRoom(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if client != nil {
+		return client.AckSnapshot(s.forwardContext(ctx), in)
+	}
+
+	if err := room.ackSnapshot(id, in.Tick); err != nil {
+		return nil, roomError("ack snapshot error", err)
+	}
+
+	return &proto.AckSnapshotRes{}, nil
+}
+
 // Run the GRPC server.
 func (s *Server) run() {
 	lis, err := net.Listen("tcp", port)
@@ -176,15 +787,37 @@ func (s *Server) run() {
 	}
 
 	uInterceptor := UnaryInterceptor{rdb: s.rdb}
-	sInterceptor := StreamInterceptor{rdb: s.rdb}
+	sInterceptor := StreamInterceptor{rdb: s.rdb, dir: s.dir, social: s.social}
 
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(uInterceptor.auth),
 		grpc.StreamInterceptor(sInterceptor.auth),
 	)
 
+	go s.dir.heartbeat()
+	go s.dir.publishLoop()
+	go s.dir.sweepLoop()
+
+	// Restore rooms from before a restart so users can resume them
+	if err := s.lobby.rehydrate(); err != nil {
+		log.Println("rehydrate rooms error:", err)
+	}
+	go s.lobby.snapshotLoop()
+	go s.chat.run()
+
+	if err := s.social.clearOnline(); err != nil {
+		log.Println("clear online users error:", err)
+	}
+	go s.social.run()
+	go s.parties.run()
+
 	proto.RegisterAuthServer(grpcServer, s)
 	proto.RegisterRoomServer(grpcServer, s)
+	proto.RegisterChatServer(grpcServer, s)
+	proto.RegisterSocialServer(grpcServer, s)
+	proto.RegisterPartyServer(grpcServer, s)
+	proto.RegisterGuildServer(grpcServer, s)
+	proto.RegisterWorldServer(grpcServer, s)
 
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
@@ -198,8 +831,8 @@ type SignUpValidator struct {
 	Pass  string `valid:"required,minstringlength(8),maxstringlength(255)"`
 }
 
-// Sanitize and validate the sign up request.
-func validateSignUpRequest(req *proto.SignUpRequest) (err error) {
+// Sanitize and validate the sign up request. Names are checked with the name filter.
+func validateSignUpRequest(req *proto.SignUpRequest, filter TextFilter) (err error) {
 	req.Name = govalidator.Trim(req.Name, "")
 	req.Email = govalidator.Trim(req.Email, "")
 	req.Name = strings.ToLower(req.Name)
@@ -211,6 +844,13 @@ func validateSignUpRequest(req *proto.SignUpRequest) (err error) {
 
 	v := SignUpValidator{Name: req.Name, Email: req.Email, Pass: req.Pass}
 	_, err = govalidator.ValidateStruct(v)
+	if err != nil {
+		return
+	}
+
+	if filter.Filter(0, req.Name).Action != FilterAllow {
+		return errors.New("name is not allowed")
+	}
 	return
 }
 
@@ -233,3 +873,104 @@ func validateSignInRequest(req *proto.SignInRequest) (err error) {
 	_, err = govalidator.ValidateStruct(v)
 	return
 }
+
+// CreateRoomValidator is used to validate create room requests.
+type CreateRoomValidator struct {
+	Name string `valid:"required,maxstringlength(32)"`
+}
+
+// Sanitize and validate the create room request. Room names are checked with the name filter.
+func validateCreateRoomRequest(req *proto.CreateRoomReq, filter TextFilter) error {
+	req.Name = govalidator.Trim(req.Name, "")
+
+	if req.Capacity == 0 {
+		req.Capacity = roomSize
+	} else if req.Capacity < 1 || req.Capacity > roomSize {
+		return fmt.Errorf("capacity must be between 1 and %d", roomSize)
+	}
+
+	if req.ReadyQuorum < 0 || req.ReadyQuorum > req.Capacity {
+		return errors.New("ready quorum must be between 0 and the capacity")
+	}
+
+	if req.SpectatorDelay < 0 || time.Duration(req.SpectatorDelay)*time.Second > maxSpectatorDelay {
+		return fmt.Errorf("spectator delay must be between 0 and %v", maxSpectatorDelay)
+	}
+
+	if len(req.Tags) > maxRoomTags {
+		return fmt.Errorf("rooms can have at most %d tags", maxRoomTags)
+	}
+
+	for i, tag := range req.Tags {
+		req.Tags[i] = strings.ToLower(govalidator.Trim(tag, ""))
+		if req.Tags[i] == "" || len(req.Tags[i]) > 16 {
+			return errors.New("tags must be between 1 and 16 characters")
+		}
+	}
+
+	v := CreateRoomValidator{Name: req.Name}
+	_, err := govalidator.ValidateStruct(v)
+	if err != nil {
+		return err
+	}
+
+	if filter.Filter(0, req.Name).Action != FilterAllow {
+		return errors.New("room name is not allowed")
+	}
+	return nil
+}
+
+// SendChatValidator is used to validate send chat requests.
+type SendChatValidator struct {
+	Text string `valid:"required"`
+}
+
+// Sanitize and validate the send chat request.
+func validateSendChatRequest(req *proto.SendChatReq) error {
+	req.Text = govalidator.Trim(req.Text, "")
+
+	if utf8.RuneCountInString(req.Text) > maxChatLength {
+		return fmt.Errorf("text must be at most %d characters", maxChatLength)
+	}
+
+	v := SendChatValidator{Text: req.Text}
+	_, err := govalidator.ValidateStruct(v)
+	return err
+}
+
+// Validate the send input request and convert it to a player input.
+// Vectors that are not finite are rejected since they would corrupt the entity's position.
+func validateSendInputRequest(req *proto.SendInputReq) (*PlayerInput, error) {
+	if req.Seq <= 0 {
+		return nil, errors.New("seq must be positive")
+	}
+
+	input := &PlayerInput{Seq: req.Seq}
+
+	switch move := req.Move.(type) {
+	case *proto.SendInputReq_Direction:
+		input.Move = vectorFromProto(move.Direction)
+		if !isFiniteVector(input.Move) {
+			return nil, errors.New("direction is not valid")
+		}
+
+	case *proto.SendInputReq_Target:
+		target := vectorFromProto(move.Target)
+		if !isFiniteVector(target) || target.magnitude() > maxTargetDistance {
+			return nil, errors.New("target is not valid")
+		}
+		input.Target = &target
+	}
+
+	return input, nil
+}
+
+// Convert a room error to a gRPC status error.
+func roomError(msg string, err error) error {
+	if err == errNotHost {
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	} else if err == errChatRate || err == errInputRate {
+		return status.Errorf(codes.ResourceExhausted, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+}
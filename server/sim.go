@@ -0,0 +1,459 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cdrpl/granny/server/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+var (
+	errNotInProgress = errors.New("Game is not in progress")
+	errInputRate     = errors.New("Sending inputs too fast")
+)
+
+// Simulation is the authoritative game state of a room while a game is in progress.
+// It is guarded by the room's mut and advanced by the room's simulation goroutine.
+type Simulation struct {
+	tick     int64
+	tickRate int
+	world    *TileMap               // Map the game is played on, nil for an empty world
+	paths    *PathCache             // Recent paths found on the map
+	entities map[int]*Entity        // User ID to the user's entity
+	grid     *SpatialGrid           // Entity positions indexed for proximity queries
+	maxReach float64                // Reach of the largest entity collider, entities further apart than this can not collide
+	inputs   map[int][]*PlayerInput // User ID to the inputs received since the last tick
+	states   []*worldState          // Recent states used as delta snapshot baselines, oldest first
+	clients  map[int]*snapshotClient
+	stop     chan struct{} // Closed to stop the simulation goroutine
+}
+
+// Entity is a player controlled object in the game world.
+type Entity struct {
+	id       int
+	position Vector
+	collider Collider
+	velocity Vector   // Units per second
+	dir      Vector   // Direction the player is moving in, zero when standing still
+	target   *Vector  // Point the player is moving to, nil when moving in a direction
+	path     []Vector // Waypoints to move through after the target, in order
+	goal     *Vector  // Point on the map the player was sent to that has not been searched for a path yet
+	nextPath int64    // First tick a path can be searched for the entity
+	inputSeq int64    // Sequence number of the last input applied
+}
+
+// PlayerInput is an input sent by a player.
+type PlayerInput struct {
+	Seq    int64   // Client sequence number, inputs that are not newer than the last applied input are ignored
+	Move   Vector  // Direction to move in, zero to stop
+	Target *Vector // Point to move to, replaces Move when set. On a map the entity follows a path to the point
+}
+
+// Create a simulation with an entity for every user. Users are spread out over the map's spawn points,
+// or evenly around the spawn circle if the map has no spawn points.
+func newSimulation(tickRate int, world *TileMap, userIDs []int) *Simulation {
+	sim := &Simulation{
+		tickRate: tickRate,
+		world:    world,
+		paths:    newPathCache(maxCachedPaths),
+		entities: make(map[int]*Entity, len(userIDs)),
+		grid:     newSpatialGrid(gridCellSize),
+		inputs:   make(map[int][]*PlayerInput),
+		clients:  make(map[int]*snapshotClient),
+		stop:     make(chan struct{}),
+	}
+
+	// Sort so every user gets the same spawn no matter the map order
+	sort.Ints(userIDs)
+	for i, id := range userIDs {
+		var position Vector
+		if world != nil && len(world.Spawns) > 0 {
+			spawn := world.Spawns[i%len(world.Spawns)]
+			position = world.tileCenter(spawn.X, spawn.Y)
+		} else {
+			angle := 2 * math.Pi * float64(i) / float64(len(userIDs))
+			position = vectorMult(Vector{X: math.Cos(angle), Y: math.Sin(angle)}, spawnRadius)
+		}
+		sim.addEntity(&Entity{id: id, position: position, collider: playerCollider})
+	}
+
+	return sim
+}
+
+// Restore a simulation from a saved game snapshot. Users without an entity in the snapshot are spawned at the
+// map spawn furthest from the other entities, or at the center if the map has no spawn points.
+func restoreSimulation(tickRate int, world *TileMap, userIDs []int, game *proto.GameSnapshot) *Simulation {
+	sim := newSimulation(tickRate, world, nil)
+	sim.tick = game.GetTick()
+
+	saved := make(map[int]*proto.EntityState)
+	for _, state := range game.GetEntities() {
+		saved[int(state.Id)] = state
+	}
+
+	// Saved entities are added first so new entities can spawn away from them
+	var missing []int
+	for _, id := range userIDs {
+		state, ok := saved[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+
+		sim.addEntity(&Entity{
+			id:       id,
+			position: Vector{X: dequantize(state.X), Y: dequantize(state.Y)},
+			collider: playerCollider,
+			inputSeq: state.InputSeq,
+		})
+	}
+
+	sort.Ints(missing)
+	for _, id := range missing {
+		sim.addEntity(&Entity{id: id, position: sim.openSpawn(), collider: playerCollider})
+	}
+
+	return sim
+}
+
+// Return the center of the map spawn with the most space around it, spawns with no entity within spawnClearance
+// all count as open and the first is picked. The center of the world is returned if the map has no spawn points.
+func (sim *Simulation) openSpawn() Vector {
+	if sim.world == nil || len(sim.world.Spawns) == 0 {
+		return Vector{}
+	}
+
+	var best Vector
+	bestDist := -1.0
+
+	for _, spawn := range sim.world.Spawns {
+		pos := sim.world.tileCenter(spawn.X, spawn.Y)
+
+		dist := spawnClearance
+		if id, ok := sim.grid.nearest(pos, spawnClearance, nil); ok {
+			other, _ := sim.grid.position(id)
+			dist = vectorDist(pos, other)
+		}
+
+		if dist > bestDist {
+			best, bestDist = pos, dist
+		}
+	}
+
+	return best
+}
+
+// Queue the input to be applied on the next tick. Return false if the user's queue is full.
+func (sim *Simulation) queueInput(userID int, input *PlayerInput) bool {
+	if len(sim.inputs[userID]) >= maxQueuedInputs {
+		return false
+	}
+
+	sim.inputs[userID] = append(sim.inputs[userID], input)
+	return true
+}
+
+// Advance the simulation by one tick. Queued inputs are applied in sequence order and every entity
+// moves at most the player speed, no matter how many inputs were sent. Paths are only searched for the last point
+// each entity was sent to. Overlapping entities are then pushed apart and out of terrain that can not be walked on.
+func (sim *Simulation) step() {
+	sim.tick++
+	dt := 1 / float64(sim.tickRate)
+
+	for id, inputs := range sim.inputs {
+		entity, ok := sim.entities[id]
+		if !ok {
+			continue
+		}
+
+		sort.Slice(inputs, func(i, j int) bool { return inputs[i].Seq < inputs[j].Seq })
+		for _, input := range inputs {
+			sim.applyInput(entity, input)
+		}
+	}
+	sim.inputs = make(map[int][]*PlayerInput)
+	sim.findPaths()
+
+	// Move in substeps of at most half a player's radius, two players moving towards each other then
+	// close less than a radius per substep and can not pass through each other or through a tile
+	substeps := int(math.Ceil(playerSpeed * dt / (playerRadius / 2)))
+	for i := 0; i < substeps; i++ {
+		for _, entity := range sim.entities {
+			entity.move(dt/float64(substeps), sim.speedAt(entity.position))
+			sim.grid.move(entity.id, entity.position)
+		}
+		sim.resolveCollisions()
+	}
+}
+
+// Apply the input to the entity. On a map, entities sent to a point wait in place until findPaths finds a path
+// around terrain that can not be walked on.
+func (sim *Simulation) applyInput(entity *Entity, input *PlayerInput) {
+	if !entity.applyInput(input) || entity.target == nil || sim.world == nil {
+		return
+	}
+
+	entity.goal, entity.target = entity.target, nil
+}
+
+// Find a path for every entity sent to a point, the path ends at the closest reachable tile if the point can not
+// be reached. Each entity is searched at most pathSearchRate times per second so sending points faster does not
+// make the simulation search more, an entity sent to a point before its next search waits for it.
+func (sim *Simulation) findPaths() {
+	interval := int64(math.Ceil(float64(sim.tickRate) / pathSearchRate))
+
+	for _, entity := range sim.entities {
+		if entity.goal == nil || sim.tick < entity.nextPath {
+			continue
+		}
+
+		waypoints, _ := sim.world.pathWaypoints(entity.position, *entity.goal, sim.paths)
+		entity.followPath(waypoints)
+		entity.goal = nil
+		entity.nextPath = sim.tick + interval
+	}
+}
+
+// Return the speed entities move at on the position, terrain with a higher cost is slower to move through.
+func (sim *Simulation) speedAt(pos Vector) float64 {
+	if sim.world == nil {
+		return playerSpeed
+	}
+
+	x, y := sim.world.tileAt(pos)
+	cost, ok := sim.world.moveCost(x, y)
+	if !ok {
+		return playerSpeed
+	}
+	return playerSpeed / cost
+}
+
+// Add the entity to the simulation.
+func (sim *Simulation) addEntity(entity *Entity) {
+	sim.entities[entity.id] = entity
+	sim.grid.insert(entity.id, entity.position)
+	sim.maxReach = math.Max(sim.maxReach, entity.collider.reach())
+}
+
+// Apply the input to the entity if it is newer than the last input applied, return false if it was not applied.
+// Directions longer than one are clamped so they can not move the entity faster than the player speed.
+func (e *Entity) applyInput(input *PlayerInput) bool {
+	if input.Seq <= e.inputSeq {
+		return false // Out of date or already applied
+	}
+
+	e.inputSeq = input.Seq
+	e.target = input.Target
+	e.path = nil
+	e.goal = nil
+	e.dir = input.Move
+	if e.dir.magnitude() > 1 {
+		e.dir = e.dir.normalize()
+	}
+	return true
+}
+
+// Move through the waypoints in order, the entity stops if there are no waypoints.
+func (e *Entity) followPath(waypoints []Vector) {
+	if len(waypoints) == 0 {
+		e.target, e.path = nil, nil
+		return
+	}
+
+	e.target = &waypoints[0]
+	e.path = waypoints[1:]
+}
+
+// Move the entity for one tick of dt seconds at the speed. Entities moving to a target carry on to the next waypoint
+// when they reach the target and stop on the last waypoint instead of overshooting it.
+func (e *Entity) move(dt, speed float64) {
+	if e.target == nil {
+		e.velocity = vectorMult(e.dir, speed)
+		e.position = vectorAdd(e.position, vectorMult(e.velocity, dt))
+		return
+	}
+
+	start := e.position
+	remaining := speed * dt
+	for e.target != nil {
+		dir := vectorDir(e.position, *e.target)
+		dist := dir.magnitude()
+		if dist > remaining {
+			e.position = vectorAdd(e.position, vectorMult(dir.normalize(), remaining))
+			break
+		}
+
+		e.position = *e.target
+		remaining -= dist
+		e.followPath(e.path)
+	}
+
+	e.velocity = vectorMult(vectorDir(start, e.position), 1/dt)
+}
+
+// Remove the user's entity and any inputs they queued.
+func (sim *Simulation) removeEntity(userID int) {
+	delete(sim.entities, userID)
+	delete(sim.inputs, userID)
+	delete(sim.clients, userID)
+	sim.grid.remove(userID)
+}
+
+// Return a full snapshot of the current state of the game.
+func (sim *Simulation) snapshot() *proto.GameSnapshot {
+	return encodeSnapshot(sim.state(), nil)
+}
+
+// Start simulating the game, mut must be locked first.
+func (r *Room) startSimulation() {
+	r.runSimulation(newSimulation(r.tickRate, r.world, r.userIDs()))
+}
+
+// Continue a game that was in progress before a restart from its saved state, mut must be locked first.
+func (r *Room) resumeSimulation(game *proto.GameSnapshot) {
+	if r.state == proto.RoomState_ROOM_STATE_IN_PROGRESS {
+		r.runSimulation(restoreSimulation(r.tickRate, r.world, r.userIDs(), game))
+	}
+}
+
+// Return the IDs of the users in the room, mut must be locked first.
+func (r *Room) userIDs() []int {
+	ids := make([]int, 0, len(r.users))
+	for id := range r.users {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Start the simulation goroutine for the room, mut must be locked first.
+func (r *Room) runSimulation(sim *Simulation) {
+	r.stopSimulation()
+	r.sim = sim
+
+	go func() {
+		ticker := time.NewTicker(time.Second / time.Duration(sim.tickRate))
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				r.mut.Lock()
+				if r.sim != sim {
+					r.mut.Unlock()
+					return // Stopped while waiting for the lock
+				}
+				sim.step()
+				r.sendSnapshots()
+				r.mut.Unlock()
+
+			case <-sim.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop the simulation if there is one, mut must be locked first.
+func (r *Room) stopSimulation() {
+	if r.sim != nil {
+		close(r.sim.stop)
+		r.sim = nil
+	}
+}
+
+// Queue a player input for the next simulation tick. Later snapshots are deltas against the acknowledged tick.
+func (r *Room) queueInput(id int, input *PlayerInput, ackTick int64) error {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return errNotInRoom
+	} else if r.sim == nil {
+		return errNotInProgress
+	}
+
+	r.sim.ackSnapshot(id, ackTick)
+
+	if !r.sim.queueInput(id, input) {
+		return errInputRate
+	}
+	return nil
+}
+
+// Record that the user received the snapshot of the tick. Inputs acknowledge snapshots as well.
+func (r *Room) ackSnapshot(id int, tick int64) error {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return errNotInRoom
+	} else if r.sim == nil {
+		return errNotInProgress
+	}
+
+	r.sim.ackSnapshot(id, tick)
+	return nil
+}
+
+// Send the game state to every connected user, mut must be locked first. Users only get the entities in their view,
+// as a delta against the last state they acknowledged or a full snapshot if that state is too old.
+// Snapshots are not numbered or kept in the history since each one replaces the last, users that are not keeping up
+// simply miss snapshots. Half of each user's buffer is left free for other events. Spectators can be on any
+// instance so a full snapshot is published for them every spectatorSnapshotInterval, they are not sent deltas
+// since they never acknowledge a snapshot.
+func (r *Room) sendSnapshots() {
+	state := r.sim.record()
+	now := time.Now()
+	timestamp := now.UnixNano() / int64(time.Millisecond)
+
+	// Full snapshot of every entity, used to measure the savings and sent to spectators
+	full := &proto.RoomEvent{
+		Seq:   r.seq,
+		Time:  timestamp,
+		Event: &proto.RoomEvent_Snapshot{Snapshot: encodeSnapshot(state, nil)},
+	}
+	fullSize := protobuf.Size(full)
+
+	if r.onSnapshot != nil && now.Sub(r.lastShown) >= spectatorSnapshotInterval {
+		r.lastShown = now
+		r.onSnapshot(full)
+	}
+
+	for _, user := range r.users {
+		if !user.connected || len(user.events) >= cap(user.events)/2 {
+			continue
+		}
+
+		client := r.sim.client(user.id)
+		view := r.sim.view(user.id, client.view)
+		baseline := r.sim.baseline(user.id)
+
+		snapshot := encodeSnapshot(state.filter(view), baseline)
+		snapshot.Entered, snapshot.Left = viewChanges(client.view, view)
+
+		event := &proto.RoomEvent{
+			Seq:   r.seq,
+			Time:  timestamp,
+			Event: &proto.RoomEvent_Snapshot{Snapshot: snapshot},
+		}
+
+		select {
+		case user.events <- event:
+			client.sent(protobuf.Size(event), fullSize, baseline == nil, now)
+			client.setView(state.tick, view)
+		default:
+		}
+	}
+}
+
+// Check neither component is NaN or infinite.
+func isFiniteVector(v Vector) bool {
+	return !math.IsNaN(v.X) && !math.IsNaN(v.Y) && !math.IsInf(v.X, 0) && !math.IsInf(v.Y, 0)
+}
+
+func vectorFromProto(v *proto.Vec2) Vector {
+	return Vector{X: float64(v.GetX()), Y: float64(v.GetY())}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"testing"
+)
+
+// Only the last point sent in a tick is searched, and points sent before the entity's next search wait for it.
+func TestSimulationPathSearches(t *testing.T) {
+	sim := newSimulation(20, newTileMap(1, 1), []int{1})
+	entity := sim.entities[1]
+	entity.position = sim.world.tileCenter(16, 16)
+
+	for i := 1; i <= maxQueuedInputs; i++ {
+		target := sim.world.tileCenter(8+i, 10)
+		sim.queueInput(1, &PlayerInput{Seq: int64(i), Target: &target})
+	}
+	sim.step()
+
+	if n := sim.paths.recency.Len(); n != 1 {
+		t.Fatalf("searched %v paths for one tick of inputs, want 1", n)
+	}
+	last := sim.world.tileCenter(8+maxQueuedInputs, 10)
+	if len(entity.path) == 0 || entity.path[len(entity.path)-1] != last {
+		t.Fatalf("path = %v, want it to end at %v", entity.path, last)
+	}
+
+	// Sent again straight away, the entity waits for its next search
+	target := sim.world.tileCenter(5, 5)
+	sim.queueInput(1, &PlayerInput{Seq: maxQueuedInputs + 1, Target: &target})
+	sim.step()
+
+	if n := sim.paths.recency.Len(); n != 1 {
+		t.Errorf("searched %v paths before the next search was allowed, want 1", n)
+	}
+	if entity.goal == nil || *entity.goal != target || entity.target != nil {
+		t.Errorf("goal, target = %v, %v, want %v, nil", entity.goal, entity.target, target)
+	}
+
+	for entity.goal != nil {
+		sim.step()
+	}
+
+	if n := sim.paths.recency.Len(); n != 2 {
+		t.Errorf("searched %v paths, want 2", n)
+	}
+	if want := int64(1 + 20/pathSearchRate); sim.tick != want {
+		t.Errorf("path searched on tick %v, want %v", sim.tick, want)
+	}
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/cdrpl/granny/server/proto"
+)
+
+// Version of the room snapshot format, snapshots with a different version are discarded on startup.
+const roomSnapshotVersion = 2
+
+// Return the state of the room for saving to Redis.
+func (r *Room) snapshot() *proto.RoomSnapshot {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	snapshot := &proto.RoomSnapshot{
+		Version:     roomSnapshotVersion,
+		Room:        r.roomInfo(),
+		HostId:      int32(r.hostID),
+		Seq:         r.seq,
+		Members:     make([]*proto.RoomMember, 0, len(r.users)),
+		ChatHistory: r.chat,
+	}
+
+	if r.sim != nil {
+		snapshot.Game = r.sim.snapshot()
+	}
+
+	for _, user := range r.users {
+		snapshot.Members = append(snapshot.Members, &proto.RoomMember{
+			User:     user.proto(),
+			Ready:    user.ready,
+			JoinedAt: user.joinedAt.UnixNano(),
+		})
+	}
+
+	return snapshot
+}
+
+// Rebuild a room from a snapshot. Every user starts disconnected and has to resume within the reconnect grace period.
+// A countdown that was running is cancelled since its timer did not survive the restart.
+// A game in progress is resumed from the saved game state once the room is added to the lobby.
+func restoreRoom(snapshot *proto.RoomSnapshot) (*Room, error) {
+	if snapshot.Version != roomSnapshotVersion {
+		return nil, errors.New("Snapshot version is not supported")
+	}
+
+	info := snapshot.Room
+	settings := RoomSettings{
+		Name:           info.Name,
+		Capacity:       int(info.Capacity),
+		Public:         info.Public,
+		Tags:           info.Tags,
+		ReadyQuorum:    int(info.ReadyQuorum),
+		SpectatorDelay: time.Duration(info.SpectatorDelay) * time.Second,
+	}
+
+	room := newRoom(info.Id, settings)
+	room.state = info.State
+	room.hostID = int(snapshot.HostId)
+	room.seq = snapshot.Seq
+	room.createdAt = time.Unix(0, info.CreatedAt*int64(time.Millisecond))
+	room.chat = snapshot.ChatHistory
+
+	if room.state == proto.RoomState_ROOM_STATE_COUNTDOWN {
+		room.state = proto.RoomState_ROOM_STATE_OPEN
+	}
+
+	for _, member := range snapshot.Members {
+		user := newRoomUser(int(member.User.Id), member.User.Name)
+		user.ready = member.Ready
+		user.joinedAt = time.Unix(0, member.JoinedAt)
+		user.connected = false
+		room.users[user.id] = user
+	}
+
+	return room, nil
+}
+
+// Start the reconnect grace period for every disconnected user in a restored room.
+// The evict function is called with the user ID and disconnect number for users that do not reconnect in time.
+func (r *Room) startEvictions(evict func(int, int)) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	for _, user := range r.users {
+		if user.connected {
+			continue
+		}
+
+		user.disconnects++
+		id, disconnects := user.id, user.disconnects
+		user.evict = time.AfterFunc(reconnectGrace, func() { evict(id, disconnects) })
+	}
+}
+
+// Save a snapshot of every room owned by this instance at a regular interval.
+func (l *Lobby) snapshotLoop() {
+	for {
+		time.Sleep(snapshotInterval)
+
+		l.mut.Lock()
+		rooms := make([]*Room, 0, len(l.rooms))
+		for _, room := range l.rooms {
+			rooms = append(rooms, room)
+		}
+		l.mut.Unlock()
+
+		snapshots := make([]*proto.RoomSnapshot, 0, len(rooms))
+		for _, room := range rooms {
+			snapshots = append(snapshots, room.snapshot())
+		}
+
+		if err := l.dir.saveSnapshots(snapshots); err != nil {
+			log.Println("save room snapshots error:", err)
+		}
+	}
+}
+
+// Restore the rooms this instance owned before it restarted.
+// Rooms that can not be restored are removed from the directory along with their users.
+func (l *Lobby) rehydrate() error {
+	ids, err := l.dir.ownedRooms()
+	if err != nil {
+		return err
+	}
+
+	restored := 0
+	for _, id := range ids {
+		snapshot, err := l.dir.loadSnapshot(id)
+		if err != nil {
+			return err
+		}
+
+		var room *Room
+		if snapshot != nil {
+			room, err = restoreRoom(snapshot)
+			if err != nil {
+				log.Printf("restore room %v error: %v\n", id, err)
+			}
+		}
+
+		if room == nil || len(room.users) == 0 {
+			if err := l.dir.removeRoom(id); err != nil {
+				return err
+			}
+			continue
+		}
+
+		l.addRoom(room)
+
+		room.mut.Lock()
+		room.resumeSimulation(snapshot.Game)
+		room.mut.Unlock()
+
+		l.mut.Lock()
+		for userID := range room.users {
+			l.userRooms[userID] = room.id
+		}
+		l.mut.Unlock()
+
+		room.startEvictions(func(userID, disconnects int) {
+			l.evictUser(room, userID, disconnects)
+		})
+		restored++
+	}
+
+	log.Printf("Restored %d rooms\n", restored)
+	return nil
+}
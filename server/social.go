@@ -0,0 +1,520 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"sync"
+
+	"github.com/cdrpl/granny/server/proto"
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Redis keys used for presence.
+const (
+	onlinePrefix    = "online:"  // online:<instance id> is the set of users with an open stream on the instance
+	presenceChanged = "presence" // Pub/sub channel that receives a user ID when the user's presence changes
+	friendsChanged  = "friends"  // Pub/sub channel that receives a user ID when the user's friends change
+	blocksChanged   = "blocks"   // Pub/sub channel that receives a user ID when the user's block relations change
+)
+
+// SocialHub tracks which users are online on this instance and sends presence changes to their friends.
+// A user is online while they have a stream open on any running instance.
+type SocialHub struct {
+	rdb      *redis.Client
+	pg       *pgxpool.Pool
+	dir      *Directory
+	sessions map[int]int             // User ID to the number of streams the user has open
+	streams  map[int]*presenceStream // User ID to the user's presence stream
+	blocks   map[int]map[int]bool    // User ID to the users they blocked or were blocked by, cached while the user is online
+	blockGen int                     // Incremented whenever cached block relations are dropped
+	mut      sync.Mutex
+}
+
+// presenceStream is an open presence stream.
+type presenceStream struct {
+	userID  int
+	friends map[int]*proto.User
+	updates chan *proto.Friend // Closed when the stream ends
+}
+
+func newSocialHub(rdb *redis.Client, pg *pgxpool.Pool, dir *Directory) *SocialHub {
+	return &SocialHub{
+		rdb:      rdb,
+		pg:       pg,
+		dir:      dir,
+		sessions: make(map[int]int),
+		streams:  make(map[int]*presenceStream),
+		blocks:   make(map[int]map[int]bool),
+	}
+}
+
+// Forget the users that were online on this instance before it restarted.
+func (h *SocialHub) clearOnline() error {
+	return h.rdb.Del(context.Background(), onlinePrefix+h.dir.instanceID).Err()
+}
+
+// Receive presence and friend list changes from every instance.
+func (h *SocialHub) run() {
+	pubsub := h.rdb.Subscribe(context.Background(), presenceChanged, friendsChanged, blocksChanged)
+	for msg := range pubsub.Channel() {
+		userID, _ := strconv.Atoi(msg.Payload)
+
+		switch msg.Channel {
+		case friendsChanged:
+			h.reloadFriends(userID)
+		case blocksChanged:
+			h.dropBlocks(userID)
+		default:
+			h.presenceChanged(userID)
+		}
+	}
+}
+
+// Record a new stream for the user, the user comes online when they open their first stream.
+func (h *SocialHub) connect(userID int) {
+	h.mut.Lock()
+	h.sessions[userID]++
+	first := h.sessions[userID] == 1
+	h.mut.Unlock()
+
+	if first {
+		h.setOnline(userID, true)
+	}
+}
+
+// Record a stream of the user closing, the user goes offline when their last stream is closed.
+func (h *SocialHub) disconnect(userID int) {
+	h.mut.Lock()
+	h.sessions[userID]--
+	last := h.sessions[userID] == 0
+	if last {
+		delete(h.sessions, userID)
+		delete(h.blocks, userID)
+	}
+	h.mut.Unlock()
+
+	if last {
+		h.setOnline(userID, false)
+	}
+}
+
+// Add or remove the user from this instance's online users.
+func (h *SocialHub) setOnline(userID int, online bool) {
+	key := onlinePrefix + h.dir.instanceID
+
+	var err error
+	if online {
+		err = h.rdb.SAdd(context.Background(), key, userID).Err()
+	} else {
+		err = h.rdb.SRem(context.Background(), key, userID).Err()
+	}
+	if err == nil {
+		err = h.dir.publishPresence(userID)
+	}
+	if err != nil {
+		log.Println("set online error:", err)
+	}
+}
+
+// Return the presence of each user.
+func (h *SocialHub) presence(users []*proto.User) ([]*proto.Friend, error) {
+	instances, err := h.dir.liveInstances()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	pipe := h.rdb.Pipeline()
+
+	online := make([][]*redis.BoolCmd, len(users))
+	rooms := make([]*redis.StringCmd, len(users))
+	for i, user := range users {
+		for _, instanceID := range instances {
+			online[i] = append(online[i], pipe.SIsMember(ctx, onlinePrefix+instanceID, user.Id))
+		}
+		rooms[i] = pipe.HGet(ctx, userRoomsKey, strconv.Itoa(int(user.Id)))
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	friends := make([]*proto.Friend, len(users))
+	for i, user := range users {
+		friends[i] = &proto.Friend{User: user, RoomId: rooms[i].Val()}
+		for _, cmd := range online[i] {
+			if cmd.Val() {
+				friends[i].Online = true
+				break
+			}
+		}
+	}
+
+	return friends, nil
+}
+
+// Send the user's presence to every local stream of their friends. Streams that are not keeping up are skipped.
+func (h *SocialHub) presenceChanged(userID int) {
+	h.mut.Lock()
+	var user *proto.User
+	streams := make([]*presenceStream, 0)
+	for _, stream := range h.streams {
+		if friend, ok := stream.friends[userID]; ok {
+			user = friend
+			streams = append(streams, stream)
+		}
+	}
+	h.mut.Unlock()
+
+	if len(streams) == 0 {
+		return
+	}
+
+	presence, err := h.presence([]*proto.User{user})
+	if err != nil {
+		log.Println("presence changed error:", err)
+		return
+	}
+
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	for _, stream := range streams {
+		if h.streams[stream.userID] != stream {
+			continue // Stream was closed
+		}
+
+		select {
+		case stream.updates <- presence[0]:
+		default:
+		}
+	}
+}
+
+// Open a presence stream for the user and return the user's friends, an existing stream for the user is closed.
+func (h *SocialHub) openStream(userID int) (*presenceStream, []*proto.User, error) {
+	friends, err := findFriends(userID, h.pg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream := &presenceStream{
+		userID:  userID,
+		friends: make(map[int]*proto.User),
+		updates: make(chan *proto.Friend, presenceStreamBuffer),
+	}
+	for _, friend := range friends {
+		stream.friends[int(friend.Id)] = friend
+	}
+
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	if old, ok := h.streams[userID]; ok {
+		close(old.updates)
+	}
+	h.streams[userID] = stream
+
+	return stream, friends, nil
+}
+
+// Close the stream if it is still the user's stream.
+func (h *SocialHub) closeStream(stream *presenceStream) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	if h.streams[stream.userID] == stream {
+		delete(h.streams, stream.userID)
+		close(stream.updates)
+	}
+}
+
+// Refresh the friends of a local stream after they changed on any instance.
+// The presence of new friends is sent to the stream.
+func (h *SocialHub) reloadFriends(userID int) {
+	h.mut.Lock()
+	_, ok := h.streams[userID]
+	h.mut.Unlock()
+	if !ok {
+		return
+	}
+
+	friends, err := findFriends(userID, h.pg)
+	if err != nil {
+		log.Println("reload friends error:", err)
+		return
+	}
+
+	h.mut.Lock()
+	stream, ok := h.streams[userID]
+	if !ok {
+		h.mut.Unlock()
+		return
+	}
+
+	added := make([]*proto.User, 0)
+	updated := make(map[int]*proto.User)
+	for _, friend := range friends {
+		if _, ok := stream.friends[int(friend.Id)]; !ok {
+			added = append(added, friend)
+		}
+		updated[int(friend.Id)] = friend
+	}
+	stream.friends = updated
+	h.mut.Unlock()
+
+	if len(added) == 0 {
+		return
+	}
+
+	presence, err := h.presence(added)
+	if err != nil {
+		log.Println("reload friends presence error:", err)
+		return
+	}
+
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	if h.streams[userID] != stream {
+		return
+	}
+
+	for _, friend := range presence {
+		select {
+		case stream.updates <- friend:
+		default:
+		}
+	}
+}
+
+// Let every instance know the friends of the users have changed.
+func (h *SocialHub) publishFriendsChanged(userIDs ...int) {
+	for _, id := range userIDs {
+		if err := h.rdb.Publish(context.Background(), friendsChanged, id).Err(); err != nil {
+			log.Println("publish friends changed error:", err)
+		}
+	}
+}
+
+// Find the ID of the user a request is addressed to by user ID or name.
+func (s *Server) resolveUser(userID int32, name string) (int, error) {
+	if name == "" {
+		if userID <= 0 {
+			return 0, status.Error(codes.InvalidArgument, "user is required")
+		}
+		return int(userID), nil
+	}
+
+	id, err := findUserIDByName(name, s.pg)
+	if err != nil {
+		log.Println(err)
+		return 0, status.Error(codes.Internal, "find user error")
+	} else if id == 0 {
+		return 0, status.Error(codes.NotFound, "user not found")
+	}
+
+	return id, nil
+}
+
+// SendFriendRequest will send a friend request to another user.
+// If the other user already sent a request to the user, the users become friends.
+func (s *Server) SendFriendRequest(ctx context.Context, in *proto.SendFriendRequestReq) (*proto.SendFriendRequestRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	friendID, err := s.resolveUser(in.GetUserId(), in.GetName())
+	if err != nil {
+		return nil, err
+	} else if friendID == id {
+		return nil, status.Error(codes.InvalidArgument, "can not friend yourself")
+	}
+
+	if _, err := findUser(friendID, s.pg); err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	if err := s.checkNotBlocked(id, friendID); err != nil {
+		return nil, err
+	}
+
+	friends, err := areFriends(id, friendID, s.pg)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "send friend request error")
+	} else if friends {
+		return nil, status.Error(codes.AlreadyExists, "already friends")
+	}
+
+	if err := s.checkFriendLimit(id); err != nil {
+		return nil, err
+	}
+
+	// A request from the other user is accepted instead of sending one back
+	accepted, err := acceptFriendRequest(friendID, id, s.pg)
+	if err != nil {
+		log.Println("accept friend request error:", err)
+		return nil, status.Error(codes.Internal, "send friend request error")
+	} else if accepted {
+		s.social.publishFriendsChanged(id, friendID)
+		return &proto.SendFriendRequestRes{Accepted: true}, nil
+	}
+
+	sent, err := insertFriendRequest(id, friendID, s.pg)
+	if err != nil {
+		log.Println("insert friend request error:", err)
+		return nil, status.Error(codes.Internal, "send friend request error")
+	} else if !sent {
+		return nil, status.Error(codes.AlreadyExists, "friend request already sent")
+	}
+
+	return &proto.SendFriendRequestRes{}, nil
+}
+
+// AcceptFriendRequest will accept a friend request sent to the user.
+func (s *Server) AcceptFriendRequest(ctx context.Context, in *proto.AcceptFriendRequestReq) (*proto.AcceptFriendRequestRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	if err := s.checkFriendLimit(id); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNotBlocked(id, int(in.UserId)); err != nil {
+		return nil, err
+	}
+
+	accepted, err := acceptFriendRequest(int(in.UserId), id, s.pg)
+	if err != nil {
+		log.Println("accept friend request error:", err)
+		return nil, status.Error(codes.Internal, "accept friend request error")
+	} else if !accepted {
+		return nil, status.Error(codes.NotFound, "friend request not found")
+	}
+
+	s.social.publishFriendsChanged(id, int(in.UserId))
+	return &proto.AcceptFriendRequestRes{}, nil
+}
+
+// DeclineFriendRequest will decline a friend request sent to the user.
+func (s *Server) DeclineFriendRequest(ctx context.Context, in *proto.DeclineFriendRequestReq) (*proto.DeclineFriendRequestRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	declined, err := deleteFriendRequest(int(in.UserId), id, s.pg)
+	if err != nil {
+		log.Println("decline friend request error:", err)
+		return nil, status.Error(codes.Internal, "decline friend request error")
+	} else if !declined {
+		return nil, status.Error(codes.NotFound, "friend request not found")
+	}
+
+	return &proto.DeclineFriendRequestRes{}, nil
+}
+
+// RemoveFriend will remove a friend, or cancel the friend request the user sent if they are not friends yet.
+func (s *Server) RemoveFriend(ctx context.Context, in *proto.RemoveFriendReq) (*proto.RemoveFriendRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	removed, err := deleteFriendship(id, int(in.UserId), s.pg)
+	if err != nil {
+		log.Println("remove friend error:", err)
+		return nil, status.Error(codes.Internal, "remove friend error")
+	} else if removed {
+		s.social.publishFriendsChanged(id, int(in.UserId))
+		return &proto.RemoveFriendRes{}, nil
+	}
+
+	cancelled, err := deleteFriendRequest(id, int(in.UserId), s.pg)
+	if err != nil {
+		log.Println("cancel friend request error:", err)
+		return nil, status.Error(codes.Internal, "remove friend error")
+	} else if !cancelled {
+		return nil, status.Error(codes.NotFound, "friend not found")
+	}
+
+	return &proto.RemoveFriendRes{}, nil
+}
+
+// ListFriends will return the user's friends with their presence and the user's pending friend requests.
+func (s *Server) ListFriends(ctx context.Context, in *proto.ListFriendsReq) (*proto.ListFriendsRes, error) {
+	id, _, _ := extractUserIDAndToken(ctx)
+
+	friends, err := findFriends(id, s.pg)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "list friends error")
+	}
+
+	incoming, err := findIncomingFriendRequests(id, s.pg)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "list friends error")
+	}
+
+	outgoing, err := findOutgoingFriendRequests(id, s.pg)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, "list friends error")
+	}
+
+	presence, err := s.social.presence(friends)
+	if err != nil {
+		log.Println("friends presence error:", err)
+		return nil, status.Error(codes.Internal, "list friends error")
+	}
+
+	return &proto.ListFriendsRes{Friends: presence, IncomingRequests: incoming, OutgoingRequests: outgoing}, nil
+}
+
+// PresenceUpdates streams the presence of the user's friends.
+func (s *Server) PresenceUpdates(req *proto.PresenceUpdatesReq, stream proto.Social_PresenceUpdatesServer) error {
+	id, _, _ := extractUserIDAndToken(stream.Context())
+
+	presenceStream, friends, err := s.social.openStream(id)
+	if err != nil {
+		log.Println("open presence stream error:", err)
+		return status.Error(codes.Internal, "presence updates error")
+	}
+	defer s.social.closeStream(presenceStream)
+
+	presence, err := s.social.presence(friends)
+	if err != nil {
+		log.Println("friends presence error:", err)
+		return status.Error(codes.Internal, "presence updates error")
+	}
+
+	for _, friend := range presence {
+		if err := stream.Send(friend); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case friend, ok := <-presenceStream.updates:
+			if !ok {
+				return nil // Another stream was opened
+			}
+			if err := stream.Send(friend); err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// Return an error if the user already has the max number of friends.
+func (s *Server) checkFriendLimit(userID int) error {
+	count, err := countFriends(userID, s.pg)
+	if err != nil {
+		log.Println(err)
+		return status.Error(codes.Internal, "friend limit error")
+	} else if count >= maxFriends {
+		return status.Errorf(codes.FailedPrecondition, "friend limit of %d reached", maxFriends)
+	}
+	return nil
+}
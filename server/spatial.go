@@ -0,0 +1,174 @@
+package main
+
+import (
+	"math"
+)
+
+// SpatialGrid is a spatial hash of entity positions. The world is divided into square cells and each entity
+// is stored in the cell that contains it, so queries only look at the cells near the area being searched.
+type SpatialGrid struct {
+	cellSize  float64
+	cells     map[GridCoord][]int // Cell to the IDs of the entities in it
+	positions map[int]Vector      // Entity ID to the entity's position
+}
+
+func newSpatialGrid(cellSize float64) *SpatialGrid {
+	return &SpatialGrid{
+		cellSize:  cellSize,
+		cells:     make(map[GridCoord][]int),
+		positions: make(map[int]Vector),
+	}
+}
+
+// Return the cell that contains the position.
+func (g *SpatialGrid) cellAt(pos Vector) GridCoord {
+	return gridCoordAt(pos, g.cellSize)
+}
+
+// Return the position of the entity.
+func (g *SpatialGrid) position(id int) (Vector, bool) {
+	pos, ok := g.positions[id]
+	return pos, ok
+}
+
+// Add the entity at the position, an entity that is already in the grid is moved instead.
+func (g *SpatialGrid) insert(id int, pos Vector) {
+	if _, ok := g.positions[id]; ok {
+		g.move(id, pos)
+		return
+	}
+
+	cell := g.cellAt(pos)
+	g.cells[cell] = append(g.cells[cell], id)
+	g.positions[id] = pos
+}
+
+// Move the entity to the position, the entity only changes cells if it has crossed into another cell.
+func (g *SpatialGrid) move(id int, pos Vector) {
+	old, ok := g.positions[id]
+	if !ok {
+		g.insert(id, pos)
+		return
+	}
+
+	g.positions[id] = pos
+
+	if from, to := g.cellAt(old), g.cellAt(pos); from != to {
+		g.removeFromCell(from, id)
+		g.cells[to] = append(g.cells[to], id)
+	}
+}
+
+// Remove the entity from the grid.
+func (g *SpatialGrid) remove(id int) {
+	pos, ok := g.positions[id]
+	if !ok {
+		return
+	}
+
+	g.removeFromCell(g.cellAt(pos), id)
+	delete(g.positions, id)
+}
+
+// Remove the entity ID from the cell, empty cells are deleted so the map only holds occupied cells.
+func (g *SpatialGrid) removeFromCell(cell GridCoord, id int) {
+	ids := g.cells[cell]
+	for i, other := range ids {
+		if other == id {
+			ids[i] = ids[len(ids)-1]
+			ids = ids[:len(ids)-1]
+			break
+		}
+	}
+
+	if len(ids) == 0 {
+		delete(g.cells, cell)
+	} else {
+		g.cells[cell] = ids
+	}
+}
+
+// Return the IDs of the entities within the radius of the center.
+func (g *SpatialGrid) queryRadius(center Vector, radius float64) []int {
+	var ids []int
+	radiusSq := radius * radius
+
+	min := g.cellAt(Vector{X: center.X - radius, Y: center.Y - radius})
+	max := g.cellAt(Vector{X: center.X + radius, Y: center.Y + radius})
+
+	for x := min.X; x <= max.X; x++ {
+		for y := min.Y; y <= max.Y; y++ {
+			for _, id := range g.cells[GridCoord{X: x, Y: y}] {
+				if vectorDistSq(g.positions[id], center) <= radiusSq {
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+
+	return ids
+}
+
+// Return the IDs of the entities inside the rectangle from min to max, edges included.
+func (g *SpatialGrid) queryRect(min, max Vector) []int {
+	var ids []int
+
+	minCell := g.cellAt(min)
+	maxCell := g.cellAt(max)
+
+	for x := minCell.X; x <= maxCell.X; x++ {
+		for y := minCell.Y; y <= maxCell.Y; y++ {
+			for _, id := range g.cells[GridCoord{X: x, Y: y}] {
+				pos := g.positions[id]
+				if pos.X >= min.X && pos.X <= max.X && pos.Y >= min.Y && pos.Y <= max.Y {
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+
+	return ids
+}
+
+// Return the ID of the entity nearest to the position within maxDist. Entities that accept returns false for are skipped,
+// a nil accept allows every entity. False is returned if there is no entity in range.
+// Cells are searched in rings moving out from the position and the search stops once no closer entity can be found.
+func (g *SpatialGrid) nearest(pos Vector, maxDist float64, accept func(id int) bool) (int, bool) {
+	center := g.cellAt(pos)
+	rings := int(math.Ceil(maxDist/g.cellSize)) + 1
+
+	found := false
+	bestID := 0
+	bestSq := maxDist * maxDist
+
+	for r := 0; r <= rings; r++ {
+		// Every entity in this ring or further out is at least r-1 cells away
+		if found && float64(r-1)*g.cellSize > math.Sqrt(bestSq) {
+			break
+		}
+
+		for x := center.X - r; x <= center.X+r; x++ {
+			for y := center.Y - r; y <= center.Y+r; y++ {
+				// Only the edge of the ring, the inside was searched by the smaller rings
+				if x != center.X-r && x != center.X+r && y != center.Y-r && y != center.Y+r {
+					continue
+				}
+
+				for _, id := range g.cells[GridCoord{X: x, Y: y}] {
+					if accept != nil && !accept(id) {
+						continue
+					}
+
+					distSq := vectorDistSq(g.positions[id], pos)
+
+					// Ties go to the lowest ID so the result does not depend on the order of the cells
+					if distSq < bestSq || (distSq == bestSq && (!found || id < bestID)) {
+						bestID, bestSq, found = id, distSq, true
+					}
+				}
+			}
+		}
+	}
+
+	return bestID, found
+}
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// gridOp is an insert, move or remove applied to a spatial grid.
+type gridOp struct {
+	op  string
+	id  int
+	pos Vector
+}
+
+func TestSpatialGridUpdate(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  []gridOp
+		want map[int]Vector
+	}{
+		{"insert", []gridOp{{"insert", 1, Vector{X: 1, Y: 2}}}, map[int]Vector{1: {X: 1, Y: 2}}},
+		{"insert same cell", []gridOp{
+			{"insert", 1, Vector{X: 1, Y: 1}},
+			{"insert", 2, Vector{X: 2, Y: 2}},
+		}, map[int]Vector{1: {X: 1, Y: 1}, 2: {X: 2, Y: 2}}},
+		{"insert again moves", []gridOp{
+			{"insert", 1, Vector{X: 1, Y: 1}},
+			{"insert", 1, Vector{X: 25, Y: -3}},
+		}, map[int]Vector{1: {X: 25, Y: -3}}},
+		{"move in cell", []gridOp{
+			{"insert", 1, Vector{X: 1, Y: 1}},
+			{"move", 1, Vector{X: 9, Y: 9}},
+		}, map[int]Vector{1: {X: 9, Y: 9}}},
+		{"move across cells", []gridOp{
+			{"insert", 1, Vector{X: 9, Y: 1}},
+			{"insert", 2, Vector{X: 1, Y: 1}},
+			{"move", 1, Vector{X: 10, Y: 1}},
+		}, map[int]Vector{1: {X: 10, Y: 1}, 2: {X: 1, Y: 1}}},
+		{"move to negative", []gridOp{
+			{"insert", 1, Vector{X: 0, Y: 0}},
+			{"move", 1, Vector{X: -0.5, Y: -15}},
+		}, map[int]Vector{1: {X: -0.5, Y: -15}}},
+		{"move missing inserts", []gridOp{{"move", 1, Vector{X: 3, Y: 4}}}, map[int]Vector{1: {X: 3, Y: 4}}},
+		{"remove", []gridOp{
+			{"insert", 1, Vector{X: 1, Y: 1}},
+			{"insert", 2, Vector{X: 2, Y: 2}},
+			{"remove", 1, Vector{}},
+		}, map[int]Vector{2: {X: 2, Y: 2}}},
+		{"remove last in cell", []gridOp{
+			{"insert", 1, Vector{X: 1, Y: 1}},
+			{"remove", 1, Vector{}},
+		}, map[int]Vector{}},
+		{"remove missing", []gridOp{
+			{"insert", 1, Vector{X: 1, Y: 1}},
+			{"remove", 2, Vector{}},
+		}, map[int]Vector{1: {X: 1, Y: 1}}},
+		{"remove after move", []gridOp{
+			{"insert", 1, Vector{X: 1, Y: 1}},
+			{"move", 1, Vector{X: 31, Y: 1}},
+			{"remove", 1, Vector{}},
+		}, map[int]Vector{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newSpatialGrid(10)
+			for _, op := range tt.ops {
+				switch op.op {
+				case "insert":
+					g.insert(op.id, op.pos)
+				case "move":
+					g.move(op.id, op.pos)
+				case "remove":
+					g.remove(op.id)
+				}
+			}
+
+			for id, want := range tt.want {
+				if got, ok := g.position(id); !ok || got != want {
+					t.Errorf("position(%v) = %v, %v, want %v, true", id, got, ok, want)
+				}
+			}
+			if len(g.positions) != len(tt.want) {
+				t.Errorf("grid has %v entities, want %v", len(g.positions), len(tt.want))
+			}
+
+			// Every entity must be in the cell holding its position and nowhere else
+			count := 0
+			for cell, ids := range g.cells {
+				if len(ids) == 0 {
+					t.Errorf("empty cell %v was kept", cell)
+				}
+				for _, id := range ids {
+					if want := g.cellAt(g.positions[id]); cell != want {
+						t.Errorf("entity %v is in cell %v, want %v", id, cell, want)
+					}
+				}
+				count += len(ids)
+			}
+			if count != len(tt.want) {
+				t.Errorf("cells hold %v entities, want %v", count, len(tt.want))
+			}
+		})
+	}
+}
+
+// Return a grid with entities on and around cell edges for the query tests.
+func testGrid() *SpatialGrid {
+	g := newSpatialGrid(10)
+	g.insert(1, Vector{X: 0, Y: 0})
+	g.insert(2, Vector{X: 5, Y: 0})
+	g.insert(3, Vector{X: 10, Y: 0})
+	g.insert(4, Vector{X: -3, Y: -4})
+	g.insert(5, Vector{X: 25, Y: 25})
+	g.insert(6, Vector{X: -10.5, Y: 0})
+	return g
+}
+
+// Return the IDs sorted so results can be compared no matter the order of the cells.
+func sortedIDs(ids []int) []int {
+	sorted := append([]int{}, ids...)
+	sort.Ints(sorted)
+	return sorted
+}
+
+func TestSpatialGridQueryRadius(t *testing.T) {
+	tests := []struct {
+		name   string
+		center Vector
+		radius float64
+		want   []int
+	}{
+		{"zero radius", Vector{}, 0, []int{1}},
+		{"edge included", Vector{}, 5, []int{1, 2, 4}},
+		{"just short", Vector{}, 4.99, []int{1}},
+		{"across cells", Vector{}, 10, []int{1, 2, 3, 4}},
+		{"negative cells", Vector{X: -10, Y: 0}, 1, []int{6}},
+		{"far cell", Vector{X: 25, Y: 24}, 1, []int{5}},
+		{"nothing in range", Vector{X: 100, Y: 100}, 10, []int{}},
+		{"everything", Vector{}, 100, []int{1, 2, 3, 4, 5, 6}},
+	}
+
+	g := testGrid()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sortedIDs(g.queryRadius(tt.center, tt.radius)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("queryRadius(%v, %v) = %v, want %v", tt.center, tt.radius, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpatialGridQueryRect(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max Vector
+		want     []int
+	}{
+		{"point", Vector{}, Vector{}, []int{1}},
+		{"edges included", Vector{X: 0, Y: 0}, Vector{X: 10, Y: 0}, []int{1, 2, 3}},
+		{"corners included", Vector{X: -3, Y: -4}, Vector{X: 0, Y: 0}, []int{1, 4}},
+		{"negative cells", Vector{X: -11, Y: -1}, Vector{X: -10, Y: 1}, []int{6}},
+		{"between entities", Vector{X: 11, Y: 11}, Vector{X: 24, Y: 24}, []int{}},
+		{"everything", Vector{X: -100, Y: -100}, Vector{X: 100, Y: 100}, []int{1, 2, 3, 4, 5, 6}},
+	}
+
+	g := testGrid()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sortedIDs(g.queryRect(tt.min, tt.max)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("queryRect(%v, %v) = %v, want %v", tt.min, tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpatialGridNearest(t *testing.T) {
+	tests := []struct {
+		name    string
+		pos     Vector
+		maxDist float64
+		skip    int // ID rejected by accept, zero accepts every entity
+		wantID  int
+		wantOK  bool
+	}{
+		{"on entity", Vector{}, 50, 0, 1, true},
+		{"nearest", Vector{X: 4}, 50, 0, 2, true},
+		{"skipped", Vector{}, 50, 1, 2, true}, // 2 and 4 are the same distance, the lower ID wins
+		{"next ring closer", Vector{X: 9.5, Y: 9.5}, 50, 0, 3, true},
+		{"max dist included", Vector{X: 25, Y: 30}, 5, 0, 5, true},
+		{"past max dist", Vector{X: 25, Y: 30}, 4.99, 0, 0, false},
+		{"max dist beyond a ring", Vector{X: 45, Y: 25}, 20, 0, 5, true},
+		{"nothing in range", Vector{X: 100, Y: 100}, 10, 0, 0, false},
+		{"zero max dist", Vector{X: 5}, 0, 0, 2, true},
+	}
+
+	g := testGrid()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var accept func(id int) bool
+			if tt.skip != 0 {
+				accept = func(id int) bool { return id != tt.skip }
+			}
+
+			id, ok := g.nearest(tt.pos, tt.maxDist, accept)
+			if id != tt.wantID || ok != tt.wantOK {
+				t.Errorf("nearest(%v, %v) = %v, %v, want %v, %v", tt.pos, tt.maxDist, id, ok, tt.wantID, tt.wantOK)
+			}
+		})
+	}
+}
+
+// The search must stop once the rings are further than the nearest entity found, without checking the far entity.
+func TestSpatialGridNearestStopsEarly(t *testing.T) {
+	g := newSpatialGrid(10)
+	g.insert(1, Vector{X: 6, Y: 5})
+	g.insert(2, Vector{X: 45, Y: 5})
+
+	checked := make(map[int]bool)
+	id, ok := g.nearest(Vector{X: 5, Y: 5}, 100, func(id int) bool {
+		checked[id] = true
+		return true
+	})
+
+	if id != 1 || !ok {
+		t.Errorf("nearest() = %v, %v, want 1, true", id, ok)
+	}
+	if checked[2] {
+		t.Error("nearest() checked an entity past the rings that could hold a closer entity")
+	}
+}
+
+// Entity counts the benchmarks are run at.
+var benchEntityCounts = []int{100, 1000, 10000}
+
+// Return a grid with n entities at random positions. The world grows with n so the density is
+// the same at every size, one entity per 100 square units.
+func benchGrid(n int) (*SpatialGrid, []Vector) {
+	rnd := rand.New(rand.NewSource(1))
+	size := 10 * math.Sqrt(float64(n))
+
+	g := newSpatialGrid(gridCellSize)
+	positions := make([]Vector, n)
+	for i := range positions {
+		positions[i] = Vector{X: rnd.Float64() * size, Y: rnd.Float64() * size}
+		g.insert(i, positions[i])
+	}
+
+	return g, positions
+}
+
+func BenchmarkSpatialGridInsert(b *testing.B) {
+	for _, n := range benchEntityCounts {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			_, positions := benchGrid(n)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				g := newSpatialGrid(gridCellSize)
+				for id, pos := range positions {
+					g.insert(id, pos)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkSpatialGridMove(b *testing.B) {
+	for _, n := range benchEntityCounts {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			g, positions := benchGrid(n)
+			step := Vector{X: 0.25, Y: 0.25}
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				id := i % n
+				positions[id] = vectorAdd(positions[id], step)
+				g.move(id, positions[id])
+			}
+		})
+	}
+}
+
+func BenchmarkSpatialGridQueryRadius(b *testing.B) {
+	for _, n := range benchEntityCounts {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			g, positions := benchGrid(n)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				g.queryRadius(positions[i%n], 20)
+			}
+		})
+	}
+}
+
+func BenchmarkSpatialGridQueryRect(b *testing.B) {
+	for _, n := range benchEntityCounts {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			g, positions := benchGrid(n)
+			half := Vector{X: 20, Y: 20}
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				pos := positions[i%n]
+				g.queryRect(vectorSub(pos, half), vectorAdd(pos, half))
+			}
+		})
+	}
+}
+
+func BenchmarkSpatialGridNearest(b *testing.B) {
+	for _, n := range benchEntityCounts {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			g, positions := benchGrid(n)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				id := i % n
+				g.nearest(positions[id], 50, func(other int) bool { return other != id })
+			}
+		})
+	}
+}
+
+// Brute force radius query for comparison, every entity is checked.
+func BenchmarkBruteForceQueryRadius(b *testing.B) {
+	for _, n := range benchEntityCounts {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			_, positions := benchGrid(n)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				center := positions[i%n]
+				var ids []int
+				for id, pos := range positions {
+					if vectorDist(center, pos) <= 20 {
+						ids = append(ids, id)
+					}
+				}
+			}
+		})
+	}
+}
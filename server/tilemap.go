@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+)
+
+// Terrain is the type of a map tile, each type matches one of the client's tile models.
+type Terrain uint8
+
+// Terrain types.
+const (
+	TerrainDirt    Terrain = iota // tile_dirt
+	TerrainRock                   // tile_rock
+	TerrainHill                   // tile_hill
+	TerrainCrystal                // tile_crystal
+	terrainCount
+)
+
+// Check if entities can walk on the terrain.
+func (t Terrain) walkable() bool {
+	return t != TerrainRock
+}
+
+var (
+	errBadMapFile    = errors.New("File is not a map")
+	errMapVersion    = errors.New("Map version is not supported")
+	errMapSize       = errors.New("Map size is not valid")
+	errBadTerrain    = errors.New("Map has an unknown terrain type")
+	errChunkNotFound = errors.New("Chunk is outside of the map")
+	errBadSpawn      = errors.New("Map has a spawn point outside of the map")
+)
+
+// Map file header, followed by the version, the width and height in chunks, the seed, the biome version,
+// the spawn points and then the tiles of every chunk.
+var mapFileMagic = [4]byte{'G', 'M', 'A', 'P'}
+
+// Version of the map file format. Version 1 maps have no seed or spawn points and version 2 maps have no biome
+// version, both can still be loaded. Maps with any other version can not be loaded.
+const mapFileVersion = 3
+
+// Chunk is a square block of chunkSize by chunkSize tiles, the unit maps are stored and streamed in.
+type Chunk struct {
+	X, Y  int                            // Chunk coordinates, the chunk holds tiles X*chunkSize to (X+1)*chunkSize-1
+	Tiles [chunkSize * chunkSize]Terrain // Row major
+}
+
+// Return the terrain of the tile at the position within the chunk.
+func (c *Chunk) tile(x, y int) Terrain {
+	return c.Tiles[y*chunkSize+x]
+}
+
+// TileMap is the overworld, a grid of square tiles stored in chunks. Tile 0,0 is the bottom left corner
+// and the center of the map is at the center of the world, so tile coordinates never go negative.
+type TileMap struct {
+	Width, Height int         // Size in chunks
+	Seed          int64       // Seed the map was generated from, zero if the map was not generated
+	Biome         int         // Version of the biome params the map was generated with, zero if the map was not generated
+	Spawns        []GridCoord // Tiles players spawn at
+	chunks        []*Chunk    // Row major
+}
+
+// Create a map of the given size in chunks with every tile set to dirt.
+func newTileMap(width, height int) *TileMap {
+	m := &TileMap{Width: width, Height: height, chunks: make([]*Chunk, width*height)}
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			m.chunks[y*width+x] = &Chunk{X: x, Y: y}
+		}
+	}
+	return m
+}
+
+// Return the width of the map in tiles.
+func (m *TileMap) tileWidth() int {
+	return m.Width * chunkSize
+}
+
+// Return the height of the map in tiles.
+func (m *TileMap) tileHeight() int {
+	return m.Height * chunkSize
+}
+
+// Return the chunk at the chunk coordinates.
+func (m *TileMap) chunk(x, y int) (*Chunk, error) {
+	if x < 0 || y < 0 || x >= m.Width || y >= m.Height {
+		return nil, errChunkNotFound
+	}
+	return m.chunks[y*m.Width+x], nil
+}
+
+// Check the tile coordinates are inside the map.
+func (m *TileMap) inBounds(x, y int) bool {
+	return x >= 0 && y >= 0 && x < m.tileWidth() && y < m.tileHeight()
+}
+
+// Return the terrain of the tile, false is returned if the tile is outside the map.
+func (m *TileMap) tile(x, y int) (Terrain, bool) {
+	if !m.inBounds(x, y) {
+		return 0, false
+	}
+
+	chunk := m.chunks[(y/chunkSize)*m.Width+x/chunkSize]
+	return chunk.tile(x%chunkSize, y%chunkSize), true
+}
+
+// Change the terrain of the tile, tiles outside the map are ignored.
+func (m *TileMap) setTile(x, y int, terrain Terrain) {
+	if !m.inBounds(x, y) {
+		return
+	}
+
+	chunk := m.chunks[(y/chunkSize)*m.Width+x/chunkSize]
+	chunk.Tiles[(y%chunkSize)*chunkSize+x%chunkSize] = terrain
+}
+
+// Return the coordinates of the tile that contains the world position.
+func (m *TileMap) tileAt(pos Vector) (int, int) {
+	x := int(math.Floor(pos.X/tileSize)) + m.tileWidth()/2
+	y := int(math.Floor(pos.Y/tileSize)) + m.tileHeight()/2
+	return x, y
+}
+
+// Return the world position of the center of the tile.
+func (m *TileMap) tileCenter(x, y int) Vector {
+	return Vector{
+		X: (float64(x-m.tileWidth()/2) + 0.5) * tileSize,
+		Y: (float64(y-m.tileHeight()/2) + 0.5) * tileSize,
+	}
+}
+
+// Write the map in the binary map format.
+func (m *TileMap) encode(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	header := struct {
+		Magic         [4]byte
+		Version       uint16
+		Width, Height uint16
+		Seed          int64
+		Biome         uint16
+		Spawns        uint16
+	}{mapFileMagic, mapFileVersion, uint16(m.Width), uint16(m.Height), m.Seed, uint16(m.Biome), uint16(len(m.Spawns))}
+
+	if err := binary.Write(bw, binary.LittleEndian, header); err != nil {
+		return err
+	}
+
+	for _, spawn := range m.Spawns {
+		if err := binary.Write(bw, binary.LittleEndian, [2]uint16{uint16(spawn.X), uint16(spawn.Y)}); err != nil {
+			return err
+		}
+	}
+
+	for _, chunk := range m.chunks {
+		if err := binary.Write(bw, binary.LittleEndian, chunk.Tiles); err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+// Return a checksum of the map in the binary map format, maps with the same checksum are the same map.
+func (m *TileMap) checksum() uint32 {
+	h := crc32.NewIEEE()
+	m.encode(h)
+	return h.Sum32()
+}
+
+// Read a map in the binary map format.
+func decodeTileMap(r io.Reader) (*TileMap, error) {
+	br := bufio.NewReader(r)
+
+	var header struct {
+		Magic         [4]byte
+		Version       uint16
+		Width, Height uint16
+	}
+
+	if err := binary.Read(br, binary.LittleEndian, &header); err != nil {
+		return nil, errBadMapFile
+	} else if header.Magic != mapFileMagic {
+		return nil, errBadMapFile
+	} else if header.Version < 1 || header.Version > mapFileVersion {
+		return nil, errMapVersion
+	} else if header.Width == 0 || header.Height == 0 || header.Width > maxMapChunks || header.Height > maxMapChunks {
+		return nil, errMapSize
+	}
+
+	m := newTileMap(int(header.Width), int(header.Height))
+
+	if header.Version >= 2 {
+		var seed int64
+		if err := binary.Read(br, binary.LittleEndian, &seed); err != nil {
+			return nil, errBadMapFile
+		}
+		m.Seed = seed
+
+		if header.Version >= 3 {
+			var biome uint16
+			if err := binary.Read(br, binary.LittleEndian, &biome); err != nil {
+				return nil, errBadMapFile
+			}
+			m.Biome = int(biome)
+		}
+
+		var spawns uint16
+		if err := binary.Read(br, binary.LittleEndian, &spawns); err != nil {
+			return nil, errBadMapFile
+		}
+
+		for i := 0; i < int(spawns); i++ {
+			var spawn [2]uint16
+			if err := binary.Read(br, binary.LittleEndian, &spawn); err != nil {
+				return nil, errBadMapFile
+			}
+			if !m.inBounds(int(spawn[0]), int(spawn[1])) {
+				return nil, errBadSpawn
+			}
+			m.Spawns = append(m.Spawns, GridCoord{X: int(spawn[0]), Y: int(spawn[1])})
+		}
+	}
+	for _, chunk := range m.chunks {
+		if err := binary.Read(br, binary.LittleEndian, &chunk.Tiles); err != nil {
+			return nil, fmt.Errorf("read chunk %d,%d error: %v", chunk.X, chunk.Y, err)
+		}
+
+		for _, terrain := range chunk.Tiles {
+			if terrain >= terrainCount {
+				return nil, errBadTerrain
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// Save the map to a file, the file is replaced once the map is fully written so a failed save does not corrupt it.
+func saveTileMap(m *TileMap, path string) error {
+	tmp := path + ".tmp"
+
+	file, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	if err := m.encode(file); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// Load a map from a file.
+func loadTileMap(path string) (*TileMap, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return decodeTileMap(file)
+}
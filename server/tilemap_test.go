@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+// Return the fields written in the map file byte order.
+func rawMapFile(fields ...interface{}) []byte {
+	var buf bytes.Buffer
+	for _, field := range fields {
+		binary.Write(&buf, binary.LittleEndian, field)
+	}
+	return buf.Bytes()
+}
+
+// Return the tiles of a map with the size in chunks, every tile is dirt except the first which is set to first.
+func rawTiles(width, height int, first byte) []byte {
+	tiles := make([]byte, width*height*chunkSize*chunkSize)
+	tiles[0] = first
+	return tiles
+}
+
+func TestTileMapRoundTrip(t *testing.T) {
+	generated := newTileMap(3, 2)
+	generated.Seed = -42
+	generated.Biome = 7
+	generated.Spawns = []GridCoord{{X: 0, Y: 0}, {X: 95, Y: 63}, {X: 40, Y: 10}}
+	generated.setTile(0, 0, TerrainRock)
+	generated.setTile(95, 63, TerrainCrystal)
+	generated.setTile(33, 40, TerrainHill)
+
+	tests := []struct {
+		name string
+		m    *TileMap
+	}{
+		{"empty", newTileMap(1, 1)},
+		{"generated", generated},
+		{"default biome", generateTileMap(1, 2, 2, defaultBiome)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.m.encode(&buf); err != nil {
+				t.Fatalf("encode() error = %v", err)
+			}
+
+			got, err := decodeTileMap(&buf)
+			if err != nil {
+				t.Fatalf("decodeTileMap() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.m) {
+				t.Errorf("decodeTileMap() = %+v, want %+v", got, tt.m)
+			}
+		})
+	}
+}
+
+func TestDecodeTileMap(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       []byte
+		err        error
+		wantSeed   int64
+		wantBiome  int
+		wantSpawns []GridCoord
+	}{
+		{"v1", rawMapFile(mapFileMagic, uint16(1), uint16(1), uint16(1), rawTiles(1, 1, 0)), nil, 0, 0, nil},
+		{"v2", rawMapFile(mapFileMagic, uint16(2), uint16(1), uint16(1), int64(42), uint16(1), [2]uint16{3, 4},
+			rawTiles(1, 1, 0)), nil, 42, 0, []GridCoord{{X: 3, Y: 4}}},
+		{"v3", rawMapFile(mapFileMagic, uint16(3), uint16(1), uint16(1), int64(42), uint16(5), uint16(1), [2]uint16{3, 4},
+			rawTiles(1, 1, byte(TerrainCrystal))), nil, 42, 5, []GridCoord{{X: 3, Y: 4}}},
+		{"empty file", nil, errBadMapFile, 0, 0, nil},
+		{"bad magic", rawMapFile([4]byte{'G', 'M', 'A', 'Q'}, uint16(1), uint16(1), uint16(1), rawTiles(1, 1, 0)),
+			errBadMapFile, 0, 0, nil},
+		{"version zero", rawMapFile(mapFileMagic, uint16(0), uint16(1), uint16(1), rawTiles(1, 1, 0)),
+			errMapVersion, 0, 0, nil},
+		{"unknown version", rawMapFile(mapFileMagic, uint16(mapFileVersion+1), uint16(1), uint16(1), rawTiles(1, 1, 0)),
+			errMapVersion, 0, 0, nil},
+		{"zero width", rawMapFile(mapFileMagic, uint16(1), uint16(0), uint16(1)), errMapSize, 0, 0, nil},
+		{"zero height", rawMapFile(mapFileMagic, uint16(1), uint16(1), uint16(0)), errMapSize, 0, 0, nil},
+		{"too wide", rawMapFile(mapFileMagic, uint16(1), uint16(maxMapChunks+1), uint16(1)), errMapSize, 0, 0, nil},
+		{"too tall", rawMapFile(mapFileMagic, uint16(1), uint16(1), uint16(maxMapChunks+1)), errMapSize, 0, 0, nil},
+		{"unknown terrain", rawMapFile(mapFileMagic, uint16(1), uint16(1), uint16(1), rawTiles(1, 1, byte(terrainCount))),
+			errBadTerrain, 0, 0, nil},
+		{"spawn outside map", rawMapFile(mapFileMagic, uint16(3), uint16(1), uint16(1), int64(1), uint16(1), uint16(1),
+			[2]uint16{chunkSize, 0}, rawTiles(1, 1, 0)), errBadSpawn, 0, 0, nil},
+		{"missing spawns", rawMapFile(mapFileMagic, uint16(3), uint16(1), uint16(1), int64(1), uint16(1), uint16(2),
+			[2]uint16{1, 1}), errBadMapFile, 0, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := decodeTileMap(bytes.NewReader(tt.data))
+			if err != tt.err {
+				t.Fatalf("decodeTileMap() error = %v, want %v", err, tt.err)
+			} else if err != nil {
+				return
+			}
+
+			if m.Seed != tt.wantSeed || m.Biome != tt.wantBiome {
+				t.Errorf("decodeTileMap() seed, biome = %v, %v, want %v, %v", m.Seed, m.Biome, tt.wantSeed, tt.wantBiome)
+			}
+			if !reflect.DeepEqual(m.Spawns, tt.wantSpawns) {
+				t.Errorf("decodeTileMap() spawns = %v, want %v", m.Spawns, tt.wantSpawns)
+			}
+		})
+	}
+}
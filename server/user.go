@@ -4,11 +4,13 @@ import "time"
 
 // User account data.
 type User struct {
-	ID        int    `json:"id"`
-	Name      string `json:"name"`
-	Email     string
-	Pass      string
-	CreatedAt time.Time
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Email      string
+	Pass       string
+	Moderator  bool
+	MutedUntil time.Time // Zero if the user has never been muted
+	CreatedAt  time.Time
 }
 
 func createUser(name, email, pass string) User {
@@ -19,3 +21,8 @@ func createUser(name, email, pass string) User {
 		CreatedAt: time.Now(),
 	}
 }
+
+// Check if a moderator has muted the user.
+func (u User) isMuted() bool {
+	return time.Now().Before(u.MutedUntil)
+}
@@ -12,9 +12,12 @@ type Vector struct {
 
 // Return the magnitude of the vector.
 func (v Vector) magnitude() float64 {
-	x := v.X * v.X
-	y := v.Y * v.Y
-	return math.Sqrt(x + y)
+	return math.Sqrt(v.magnitudeSq())
+}
+
+// Return the squared magnitude of the vector, cheaper than the magnitude when only comparing lengths.
+func (v Vector) magnitudeSq() float64 {
+	return v.X*v.X + v.Y*v.Y
 }
 
 // Return the normalized vector
@@ -31,6 +34,30 @@ func (v Vector) normalize() Vector {
 	}
 }
 
+// Return the vector rotated counterclockwise by the angle in radians.
+func (v Vector) rotate(angle float64) Vector {
+	sin, cos := math.Sincos(angle)
+	return Vector{
+		X: v.X*cos - v.Y*sin,
+		Y: v.X*sin + v.Y*cos,
+	}
+}
+
+// Return the angle in radians from the positive X axis to the vector, between -Pi and Pi.
+func (v Vector) angle() float64 {
+	return math.Atan2(v.Y, v.X)
+}
+
+// Return the vector shortened to the max magnitude if it is longer, the direction is kept.
+func (v Vector) clampMagnitude(max float64) Vector {
+	magSq := v.magnitudeSq()
+	if magSq <= max*max {
+		return v
+	}
+
+	return vectorMult(v, max/math.Sqrt(magSq))
+}
+
 // Add the two vectors together.
 func vectorAdd(v1, v2 Vector) Vector {
 	return Vector{
@@ -39,6 +66,14 @@ func vectorAdd(v1, v2 Vector) Vector {
 	}
 }
 
+// Subtract the second vector from the first.
+func vectorSub(v1, v2 Vector) Vector {
+	return Vector{
+		X: v1.X - v2.X,
+		Y: v1.Y - v2.Y,
+	}
+}
+
 // Multiply the vector by the given value.
 func vectorMult(v Vector, val float64) Vector {
 	return Vector{
@@ -49,16 +84,225 @@ func vectorMult(v Vector, val float64) Vector {
 
 // Return the distance from the given vector.
 func vectorDist(v1, v2 Vector) float64 {
-	x := math.Pow(v1.X-v2.X, 2)
-	y := math.Pow(v1.Y-v2.Y, 2)
-	dist := math.Sqrt(x + y)
-	return math.Abs(dist)
+	return math.Sqrt(vectorDistSq(v1, v2))
+}
+
+// Return the squared distance from the given vector, cheaper than the distance when only comparing distances.
+func vectorDistSq(v1, v2 Vector) float64 {
+	x := v1.X - v2.X
+	y := v1.Y - v2.Y
+	return x*x + y*y
 }
 
 // Return a directional vector from pos to dest.
 func vectorDir(src, dest Vector) Vector {
+	return vectorSub(dest, src)
+}
+
+// Return the dot product of the two vectors.
+func vectorDot(v1, v2 Vector) float64 {
+	return v1.X*v2.X + v1.Y*v2.Y
+}
+
+// Return the Z component of the cross product of the two vectors. It is positive if the second vector
+// is counterclockwise from the first, negative if it is clockwise and zero if they are parallel.
+func vectorCross(v1, v2 Vector) float64 {
+	return v1.X*v2.Y - v1.Y*v2.X
+}
+
+// Return the signed angle in radians to rotate the first vector by to point it in the direction of the second,
+// between -Pi and Pi. Counterclockwise is positive.
+func vectorAngle(v1, v2 Vector) float64 {
+	return math.Atan2(vectorCross(v1, v2), vectorDot(v1, v2))
+}
+
+// Return the point the fraction t of the way from the first vector to the second, t is not clamped.
+func vectorLerp(v1, v2 Vector, t float64) Vector {
+	return Vector{
+		X: lerp(v1.X, v2.X, t),
+		Y: lerp(v1.Y, v2.Y, t),
+	}
+}
+
+// Return the projection of the vector onto the other vector, zero if the other vector is zero.
+func vectorProject(v, onto Vector) Vector {
+	lenSq := onto.magnitudeSq()
+	if lenSq == 0 {
+		return Vector{}
+	}
+
+	return vectorMult(onto, vectorDot(v, onto)/lenSq)
+}
+
+// Return the vector reflected off a surface with the given normal, the normal must be normalized.
+func vectorReflect(v, normal Vector) Vector {
+	return vectorAdd(v, vectorMult(normal, -2*vectorDot(v, normal)))
+}
+
+// Check if every component of the vectors is within epsilon of each other, used to compare results
+// that may have picked up floating point errors.
+func vectorApproxEqual(v1, v2 Vector, epsilon float64) bool {
+	return math.Abs(v1.X-v2.X) <= epsilon && math.Abs(v1.Y-v2.Y) <= epsilon
+}
+
+// Vector3 is used to represent positions in 3D, such as the client's world space. Y is up.
+type Vector3 struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// Return the magnitude of the vector.
+func (v Vector3) magnitude() float64 {
+	return math.Sqrt(v.X*v.X + v.Y*v.Y + v.Z*v.Z)
+}
+
+// Return the normalized vector.
+func (v Vector3) normalize() Vector3 {
+	mag := v.magnitude()
+
+	if mag == 0 {
+		return Vector3{}
+	}
+
+	return Vector3{
+		X: v.X / mag,
+		Y: v.Y / mag,
+		Z: v.Z / mag,
+	}
+}
+
+// Return the vector on the ground plane, the 3D X and Z axes become the 2D X and Y axes.
+func (v Vector3) ground() Vector {
+	return Vector{X: v.X, Y: v.Z}
+}
+
+// Return the 3D vector on the ground plane at the height, the inverse of ground.
+func vectorFromGround(v Vector, height float64) Vector3 {
+	return Vector3{X: v.X, Y: height, Z: v.Y}
+}
+
+// Add the two vectors together.
+func vector3Add(v1, v2 Vector3) Vector3 {
+	return Vector3{
+		X: v1.X + v2.X,
+		Y: v1.Y + v2.Y,
+		Z: v1.Z + v2.Z,
+	}
+}
+
+// Subtract the second vector from the first.
+func vector3Sub(v1, v2 Vector3) Vector3 {
+	return Vector3{
+		X: v1.X - v2.X,
+		Y: v1.Y - v2.Y,
+		Z: v1.Z - v2.Z,
+	}
+}
+
+// Multiply the vector by the given value.
+func vector3Mult(v Vector3, val float64) Vector3 {
+	return Vector3{
+		X: v.X * val,
+		Y: v.Y * val,
+		Z: v.Z * val,
+	}
+}
+
+// Return the distance between the vectors.
+func vector3Dist(v1, v2 Vector3) float64 {
+	return vector3Sub(v1, v2).magnitude()
+}
+
+// Return the dot product of the two vectors.
+func vector3Dot(v1, v2 Vector3) float64 {
+	return v1.X*v2.X + v1.Y*v2.Y + v1.Z*v2.Z
+}
+
+// Return the cross product of the two vectors, perpendicular to both.
+func vector3Cross(v1, v2 Vector3) Vector3 {
+	return Vector3{
+		X: v1.Y*v2.Z - v1.Z*v2.Y,
+		Y: v1.Z*v2.X - v1.X*v2.Z,
+		Z: v1.X*v2.Y - v1.Y*v2.X,
+	}
+}
+
+// Return the point the fraction t of the way from the first vector to the second, t is not clamped.
+func vector3Lerp(v1, v2 Vector3, t float64) Vector3 {
+	return Vector3{
+		X: lerp(v1.X, v2.X, t),
+		Y: lerp(v1.Y, v2.Y, t),
+		Z: lerp(v1.Z, v2.Z, t),
+	}
+}
+
+// Check if every component of the vectors is within epsilon of each other.
+func vector3ApproxEqual(v1, v2 Vector3, epsilon float64) bool {
+	return math.Abs(v1.X-v2.X) <= epsilon && math.Abs(v1.Y-v2.Y) <= epsilon && math.Abs(v1.Z-v2.Z) <= epsilon
+}
+
+// GridCoord is the integer coordinates of a cell in a grid, such as a map tile or a spatial grid cell.
+type GridCoord struct {
+	X, Y int
+}
+
+// Return the grid cell of the given size that contains the position.
+func gridCoordAt(pos Vector, cellSize float64) GridCoord {
+	return GridCoord{X: int(math.Floor(pos.X / cellSize)), Y: int(math.Floor(pos.Y / cellSize))}
+}
+
+// Return the position of the center of the grid cell of the given size.
+func (c GridCoord) center(cellSize float64) Vector {
 	return Vector{
-		X: dest.X - src.X,
-		Y: dest.Y - src.Y,
+		X: (float64(c.X) + 0.5) * cellSize,
+		Y: (float64(c.Y) + 0.5) * cellSize,
+	}
+}
+
+// Add the two grid coordinates together.
+func gridAdd(c1, c2 GridCoord) GridCoord {
+	return GridCoord{X: c1.X + c2.X, Y: c1.Y + c2.Y}
+}
+
+// Return the number of straight steps between the grid cells.
+func gridManhattan(c1, c2 GridCoord) int {
+	return abs(c1.X-c2.X) + abs(c1.Y-c2.Y)
+}
+
+// Return the number of steps between the grid cells when diagonal steps are allowed.
+func gridChebyshev(c1, c2 GridCoord) int {
+	dx, dy := abs(c1.X-c2.X), abs(c1.Y-c2.Y)
+	if dx > dy {
+		return dx
+	}
+	return dy
+}
+
+// Return the value the fraction t of the way from a to b, t is not clamped.
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func sign(v int) int {
+	if v < 0 {
+		return -1
+	} else if v > 0 {
+		return 1
 	}
+	return 0
 }
@@ -0,0 +1,544 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// Tolerance for comparing results that may have picked up floating point errors.
+const epsilon = 1e-9
+
+func TestVectorMagnitude(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector
+		want float64
+	}{
+		{"zero", Vector{}, 0},
+		{"x axis", Vector{X: -3}, 3},
+		{"y axis", Vector{Y: 2}, 2},
+		{"3 4 5", Vector{X: 3, Y: -4}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.magnitude(); math.Abs(got-tt.want) > epsilon {
+				t.Errorf("magnitude() = %v, want %v", got, tt.want)
+			}
+			if got := tt.v.magnitudeSq(); math.Abs(got-tt.want*tt.want) > epsilon {
+				t.Errorf("magnitudeSq() = %v, want %v", got, tt.want*tt.want)
+			}
+		})
+	}
+}
+
+func TestVectorNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector
+		want Vector
+	}{
+		{"zero", Vector{}, Vector{}},
+		{"unit", Vector{X: 1}, Vector{X: 1}},
+		{"long", Vector{X: 3, Y: -4}, Vector{X: 0.6, Y: -0.8}},
+		{"short", Vector{Y: 0.25}, Vector{Y: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.normalize(); !vectorApproxEqual(got, tt.want, epsilon) {
+				t.Errorf("normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVectorRotate(t *testing.T) {
+	tests := []struct {
+		name  string
+		v     Vector
+		angle float64
+		want  Vector
+	}{
+		{"none", Vector{X: 1, Y: 2}, 0, Vector{X: 1, Y: 2}},
+		{"quarter", Vector{X: 1}, math.Pi / 2, Vector{Y: 1}},
+		{"half", Vector{X: 1, Y: 2}, math.Pi, Vector{X: -1, Y: -2}},
+		{"clockwise", Vector{Y: 3}, -math.Pi / 2, Vector{X: 3}},
+		{"eighth", Vector{X: 1}, math.Pi / 4, Vector{X: math.Sqrt2 / 2, Y: math.Sqrt2 / 2}},
+		{"full", Vector{X: 1, Y: 2}, 2 * math.Pi, Vector{X: 1, Y: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.rotate(tt.angle); !vectorApproxEqual(got, tt.want, epsilon) {
+				t.Errorf("rotate(%v) = %v, want %v", tt.angle, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVectorAngle(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector
+		want float64
+	}{
+		{"x axis", Vector{X: 2}, 0},
+		{"y axis", Vector{Y: 2}, math.Pi / 2},
+		{"negative y axis", Vector{Y: -2}, -math.Pi / 2},
+		{"negative x axis", Vector{X: -2}, math.Pi},
+		{"diagonal", Vector{X: 1, Y: 1}, math.Pi / 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.angle(); math.Abs(got-tt.want) > epsilon {
+				t.Errorf("angle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVectorAngleBetween(t *testing.T) {
+	tests := []struct {
+		name   string
+		v1, v2 Vector
+		want   float64
+	}{
+		{"same", Vector{X: 1}, Vector{X: 5}, 0},
+		{"counterclockwise", Vector{X: 1}, Vector{Y: 1}, math.Pi / 2},
+		{"clockwise", Vector{Y: 1}, Vector{X: 1}, -math.Pi / 2},
+		{"opposite", Vector{X: 1}, Vector{X: -1}, math.Pi},
+		{"crosses the x axis", Vector{X: 1, Y: -1}, Vector{X: 1, Y: 1}, math.Pi / 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vectorAngle(tt.v1, tt.v2); math.Abs(got-tt.want) > epsilon {
+				t.Errorf("vectorAngle(%v, %v) = %v, want %v", tt.v1, tt.v2, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVectorClampMagnitude(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector
+		max  float64
+		want Vector
+	}{
+		{"zero", Vector{}, 1, Vector{}},
+		{"shorter", Vector{X: 0.5}, 1, Vector{X: 0.5}},
+		{"equal", Vector{Y: -1}, 1, Vector{Y: -1}},
+		{"longer", Vector{X: 3, Y: 4}, 1, Vector{X: 0.6, Y: 0.8}},
+		{"zero max", Vector{X: 3, Y: 4}, 0, Vector{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.clampMagnitude(tt.max); !vectorApproxEqual(got, tt.want, epsilon) {
+				t.Errorf("clampMagnitude(%v) = %v, want %v", tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVectorArithmetic(t *testing.T) {
+	tests := []struct {
+		name      string
+		v1, v2    Vector
+		val       float64
+		add, sub  Vector
+		mult, dir Vector
+	}{
+		{"zero", Vector{}, Vector{}, 2, Vector{}, Vector{}, Vector{}, Vector{}},
+		{"positive", Vector{X: 1, Y: 2}, Vector{X: 3, Y: 5}, 2, Vector{X: 4, Y: 7}, Vector{X: -2, Y: -3}, Vector{X: 2, Y: 4}, Vector{X: 2, Y: 3}},
+		{"negative", Vector{X: -1, Y: 4}, Vector{X: 2, Y: -2}, -0.5, Vector{X: 1, Y: 2}, Vector{X: -3, Y: 6}, Vector{X: 0.5, Y: -2}, Vector{X: 3, Y: -6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vectorAdd(tt.v1, tt.v2); got != tt.add {
+				t.Errorf("vectorAdd() = %v, want %v", got, tt.add)
+			}
+			if got := vectorSub(tt.v1, tt.v2); got != tt.sub {
+				t.Errorf("vectorSub() = %v, want %v", got, tt.sub)
+			}
+			if got := vectorMult(tt.v1, tt.val); got != tt.mult {
+				t.Errorf("vectorMult() = %v, want %v", got, tt.mult)
+			}
+			if got := vectorDir(tt.v1, tt.v2); got != tt.dir {
+				t.Errorf("vectorDir() = %v, want %v", got, tt.dir)
+			}
+		})
+	}
+}
+
+func TestVectorDist(t *testing.T) {
+	tests := []struct {
+		name   string
+		v1, v2 Vector
+		want   float64
+	}{
+		{"same", Vector{X: 1, Y: 1}, Vector{X: 1, Y: 1}, 0},
+		{"axis", Vector{X: -2}, Vector{X: 3}, 5},
+		{"3 4 5", Vector{X: 1, Y: 1}, Vector{X: 4, Y: 5}, 5},
+		{"reversed", Vector{X: 4, Y: 5}, Vector{X: 1, Y: 1}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vectorDist(tt.v1, tt.v2); math.Abs(got-tt.want) > epsilon {
+				t.Errorf("vectorDist() = %v, want %v", got, tt.want)
+			}
+			if got := vectorDistSq(tt.v1, tt.v2); math.Abs(got-tt.want*tt.want) > epsilon {
+				t.Errorf("vectorDistSq() = %v, want %v", got, tt.want*tt.want)
+			}
+		})
+	}
+}
+
+func TestVectorDotCross(t *testing.T) {
+	tests := []struct {
+		name   string
+		v1, v2 Vector
+		dot    float64
+		cross  float64
+	}{
+		{"zero", Vector{}, Vector{X: 1, Y: 2}, 0, 0},
+		{"parallel", Vector{X: 1, Y: 2}, Vector{X: 2, Y: 4}, 10, 0},
+		{"opposite", Vector{X: 1}, Vector{X: -3}, -3, 0},
+		{"counterclockwise", Vector{X: 2}, Vector{Y: 3}, 0, 6},
+		{"clockwise", Vector{Y: 3}, Vector{X: 2}, 0, -6},
+		{"general", Vector{X: 1, Y: 2}, Vector{X: 3, Y: -1}, 1, -7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vectorDot(tt.v1, tt.v2); got != tt.dot {
+				t.Errorf("vectorDot() = %v, want %v", got, tt.dot)
+			}
+			if got := vectorCross(tt.v1, tt.v2); got != tt.cross {
+				t.Errorf("vectorCross() = %v, want %v", got, tt.cross)
+			}
+		})
+	}
+}
+
+func TestVectorLerp(t *testing.T) {
+	tests := []struct {
+		name   string
+		v1, v2 Vector
+		t      float64
+		want   Vector
+	}{
+		{"start", Vector{X: 1, Y: 2}, Vector{X: 3, Y: 6}, 0, Vector{X: 1, Y: 2}},
+		{"end", Vector{X: 1, Y: 2}, Vector{X: 3, Y: 6}, 1, Vector{X: 3, Y: 6}},
+		{"middle", Vector{X: 1, Y: 2}, Vector{X: 3, Y: 6}, 0.5, Vector{X: 2, Y: 4}},
+		{"past the end", Vector{}, Vector{X: 2}, 1.5, Vector{X: 3}},
+		{"before the start", Vector{}, Vector{X: 2}, -1, Vector{X: -2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vectorLerp(tt.v1, tt.v2, tt.t); !vectorApproxEqual(got, tt.want, epsilon) {
+				t.Errorf("vectorLerp(%v) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVectorProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		v, onto Vector
+		want    Vector
+	}{
+		{"onto zero", Vector{X: 1, Y: 1}, Vector{}, Vector{}},
+		{"onto axis", Vector{X: 3, Y: 4}, Vector{X: 2}, Vector{X: 3}},
+		{"perpendicular", Vector{Y: 4}, Vector{X: 1}, Vector{}},
+		{"opposite", Vector{X: -2, Y: 1}, Vector{X: 1}, Vector{X: -2}},
+		{"diagonal", Vector{X: 2}, Vector{X: 1, Y: 1}, Vector{X: 1, Y: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vectorProject(tt.v, tt.onto); !vectorApproxEqual(got, tt.want, epsilon) {
+				t.Errorf("vectorProject() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVectorReflect(t *testing.T) {
+	tests := []struct {
+		name      string
+		v, normal Vector
+		want      Vector
+	}{
+		{"head on", Vector{X: -2}, Vector{X: 1}, Vector{X: 2}},
+		{"angled", Vector{X: 1, Y: -1}, Vector{Y: 1}, Vector{X: 1, Y: 1}},
+		{"along the surface", Vector{X: 3}, Vector{Y: 1}, Vector{X: 3}},
+		{"diagonal surface", Vector{X: 1}, Vector{X: -math.Sqrt2 / 2, Y: math.Sqrt2 / 2}, Vector{Y: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vectorReflect(tt.v, tt.normal); !vectorApproxEqual(got, tt.want, epsilon) {
+				t.Errorf("vectorReflect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVectorApproxEqual(t *testing.T) {
+	tests := []struct {
+		name    string
+		v1, v2  Vector
+		epsilon float64
+		want    bool
+	}{
+		{"equal", Vector{X: 1, Y: 2}, Vector{X: 1, Y: 2}, 0, true},
+		{"within epsilon", Vector{X: 1, Y: 2}, Vector{X: 1.05, Y: 1.95}, 0.1, true},
+		{"x outside epsilon", Vector{X: 1, Y: 2}, Vector{X: 1.2, Y: 2}, 0.1, false},
+		{"y outside epsilon", Vector{X: 1, Y: 2}, Vector{X: 1, Y: 1.8}, 0.1, false},
+		{"floating point error", Vector{X: 0.1 + 0.2}, Vector{X: 0.3}, epsilon, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vectorApproxEqual(tt.v1, tt.v2, tt.epsilon); got != tt.want {
+				t.Errorf("vectorApproxEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3(t *testing.T) {
+	tests := []struct {
+		name     string
+		v1, v2   Vector3
+		add, sub Vector3
+		dot      float64
+		cross    Vector3
+		dist     float64
+	}{
+		{"zero", Vector3{}, Vector3{}, Vector3{}, Vector3{}, 0, Vector3{}, 0},
+		{"axes", Vector3{X: 1}, Vector3{Y: 1}, Vector3{X: 1, Y: 1}, Vector3{X: 1, Y: -1}, 0, Vector3{Z: 1}, math.Sqrt2},
+		{"axes reversed", Vector3{Y: 1}, Vector3{X: 1}, Vector3{X: 1, Y: 1}, Vector3{X: -1, Y: 1}, 0, Vector3{Z: -1}, math.Sqrt2},
+		{
+			"general", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 4, Y: 5, Z: 6},
+			Vector3{X: 5, Y: 7, Z: 9}, Vector3{X: -3, Y: -3, Z: -3}, 32, Vector3{X: -3, Y: 6, Z: -3}, math.Sqrt(27),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vector3Add(tt.v1, tt.v2); got != tt.add {
+				t.Errorf("vector3Add() = %v, want %v", got, tt.add)
+			}
+			if got := vector3Sub(tt.v1, tt.v2); got != tt.sub {
+				t.Errorf("vector3Sub() = %v, want %v", got, tt.sub)
+			}
+			if got := vector3Dot(tt.v1, tt.v2); got != tt.dot {
+				t.Errorf("vector3Dot() = %v, want %v", got, tt.dot)
+			}
+			if got := vector3Cross(tt.v1, tt.v2); got != tt.cross {
+				t.Errorf("vector3Cross() = %v, want %v", got, tt.cross)
+			}
+			if got := vector3Dist(tt.v1, tt.v2); math.Abs(got-tt.dist) > epsilon {
+				t.Errorf("vector3Dist() = %v, want %v", got, tt.dist)
+			}
+			if got := vector3Lerp(tt.v1, tt.v2, 0.5); !vector3ApproxEqual(got, vector3Mult(tt.add, 0.5), epsilon) {
+				t.Errorf("vector3Lerp() = %v, want %v", got, vector3Mult(tt.add, 0.5))
+			}
+		})
+	}
+}
+
+func TestVector3Normalize(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector3
+		want Vector3
+	}{
+		{"zero", Vector3{}, Vector3{}},
+		{"axis", Vector3{Z: -4}, Vector3{Z: -1}},
+		{"general", Vector3{X: 2, Y: 3, Z: 6}, Vector3{X: 2.0 / 7, Y: 3.0 / 7, Z: 6.0 / 7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.normalize(); !vector3ApproxEqual(got, tt.want, epsilon) {
+				t.Errorf("normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3Ground(t *testing.T) {
+	tests := []struct {
+		name   string
+		v      Vector
+		height float64
+		want   Vector3
+	}{
+		{"zero", Vector{}, 0, Vector3{}},
+		{"ground", Vector{X: 1, Y: 2}, 0, Vector3{X: 1, Z: 2}},
+		{"raised", Vector{X: -1, Y: 3}, 5, Vector3{X: -1, Y: 5, Z: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := vectorFromGround(tt.v, tt.height)
+			if got != tt.want {
+				t.Errorf("vectorFromGround() = %v, want %v", got, tt.want)
+			}
+			if back := got.ground(); back != tt.v {
+				t.Errorf("ground() = %v, want %v", back, tt.v)
+			}
+		})
+	}
+}
+
+func TestGridCoord(t *testing.T) {
+	tests := []struct {
+		name     string
+		pos      Vector
+		cellSize float64
+		want     GridCoord
+	}{
+		{"origin", Vector{}, 2, GridCoord{}},
+		{"inside first cell", Vector{X: 1.9, Y: 0.1}, 2, GridCoord{}},
+		{"cell edge", Vector{X: 2, Y: 4}, 2, GridCoord{X: 1, Y: 2}},
+		{"negative", Vector{X: -0.1, Y: -2}, 2, GridCoord{X: -1, Y: -1}},
+		{"negative past edge", Vector{X: -2.1}, 2, GridCoord{X: -2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := gridCoordAt(tt.pos, tt.cellSize)
+			if got != tt.want {
+				t.Errorf("gridCoordAt(%v) = %v, want %v", tt.pos, got, tt.want)
+			}
+			if center := got.center(tt.cellSize); gridCoordAt(center, tt.cellSize) != got {
+				t.Errorf("center() = %v is not in cell %v", center, got)
+			}
+		})
+	}
+}
+
+func TestGridDist(t *testing.T) {
+	tests := []struct {
+		name      string
+		c1, c2    GridCoord
+		manhattan int
+		chebyshev int
+	}{
+		{"same", GridCoord{X: 1, Y: 1}, GridCoord{X: 1, Y: 1}, 0, 0},
+		{"straight", GridCoord{}, GridCoord{X: 3}, 3, 3},
+		{"diagonal", GridCoord{}, GridCoord{X: -2, Y: 2}, 4, 2},
+		{"general", GridCoord{X: 1, Y: -1}, GridCoord{X: 4, Y: 6}, 10, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gridManhattan(tt.c1, tt.c2); got != tt.manhattan {
+				t.Errorf("gridManhattan() = %v, want %v", got, tt.manhattan)
+			}
+			if got := gridChebyshev(tt.c1, tt.c2); got != tt.chebyshev {
+				t.Errorf("gridChebyshev() = %v, want %v", got, tt.chebyshev)
+			}
+			if got := gridAdd(tt.c1, GridCoord{X: tt.c2.X - tt.c1.X, Y: tt.c2.Y - tt.c1.Y}); got != tt.c2 {
+				t.Errorf("gridAdd() = %v, want %v", got, tt.c2)
+			}
+		})
+	}
+}
+
+// Results are stored in a package variable so the compiler can not optimize the benchmarked calls away.
+var (
+	benchFloat   float64
+	benchVector  Vector
+	benchVector3 Vector3
+)
+
+var benchA, benchB = Vector{X: 1.5, Y: -2.25}, Vector{X: -3.75, Y: 4.5}
+
+func BenchmarkVectorMagnitude(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchFloat = benchA.magnitude()
+	}
+}
+
+func BenchmarkVectorNormalize(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchVector = benchA.normalize()
+	}
+}
+
+func BenchmarkVectorDist(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchFloat = vectorDist(benchA, benchB)
+	}
+}
+
+func BenchmarkVectorDistSq(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchFloat = vectorDistSq(benchA, benchB)
+	}
+}
+
+// The distance as vectorDist used to calculate it, with math.Pow and math.Abs, for comparison.
+func BenchmarkVectorDistPow(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		x := math.Pow(benchA.X-benchB.X, 2)
+		y := math.Pow(benchA.Y-benchB.Y, 2)
+		benchFloat = math.Abs(math.Sqrt(x + y))
+	}
+}
+
+func BenchmarkVectorRotate(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchVector = benchA.rotate(0.5)
+	}
+}
+
+func BenchmarkVectorAngle(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchFloat = vectorAngle(benchA, benchB)
+	}
+}
+
+func BenchmarkVectorLerp(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchVector = vectorLerp(benchA, benchB, 0.25)
+	}
+}
+
+func BenchmarkVectorClampMagnitude(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchVector = benchB.clampMagnitude(1)
+	}
+}
+
+func BenchmarkVectorProject(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchVector = vectorProject(benchA, benchB)
+	}
+}
+
+func BenchmarkVector3Cross(b *testing.B) {
+	v1, v2 := Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: -4, Y: 5, Z: -6}
+	for i := 0; i < b.N; i++ {
+		benchVector3 = vector3Cross(v1, v2)
+	}
+}
+
+func BenchmarkVector3Normalize(b *testing.B) {
+	v := Vector3{X: 1, Y: 2, Z: 3}
+	for i := 0; i < b.N; i++ {
+		benchVector3 = v.normalize()
+	}
+}
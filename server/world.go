@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cdrpl/granny/server/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Load the overworld map. If there is no map file a map is generated from the WORLD_SEED env var and saved.
+// Players can get chunks from any instance while their game runs on the instance that owns the room, so an error
+// is returned if the map does not match the map of the other running instances.
+func loadWorld(dir *Directory) (*TileMap, error) {
+	world, err := loadTileMap(mapFile)
+	if os.IsNotExist(err) {
+		world, err = generateWorld(dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := dir.checkWorld(world.checksum()); err != nil {
+		return nil, err
+	}
+	return world, nil
+}
+
+// Generate the overworld map and save it. A random seed is used if WORLD_SEED is not set, which is only allowed
+// when no other instance is running since the other instances would have a different map.
+func generateWorld(dir *Directory) (*TileMap, error) {
+	var seed int64
+	if env := os.Getenv("WORLD_SEED"); env != "" {
+		var err error
+		if seed, err = strconv.ParseInt(env, 10, 64); err != nil {
+			return nil, errors.New("WORLD_SEED must be an integer")
+		}
+	} else {
+		others, err := dir.otherInstances()
+		if err != nil {
+			return nil, err
+		} else if len(others) > 0 {
+			return nil, errors.New("WORLD_SEED must be set or the map file shared when running multiple instances")
+		}
+		seed = time.Now().UnixNano()
+	}
+
+	log.Printf("No map at %v, generating a map with seed %v\n", mapFile, seed)
+	world := generateTileMap(seed, defaultMapChunks, defaultMapChunks, defaultBiome)
+
+	if err := os.MkdirAll(filepath.Dir(mapFile), 0755); err != nil {
+		return nil, err
+	}
+	return world, saveTileMap(world, mapFile)
+}
+
+// GetMapInfo will return the size of the overworld map.
+func (s *Server) GetMapInfo(ctx context.Context, in *proto.GetMapInfoReq) (*proto.GetMapInfoRes, error) {
+	return &proto.GetMapInfoRes{
+		Width:     int32(s.world.Width),
+		Height:    int32(s.world.Height),
+		ChunkSize: chunkSize,
+		TileSize:  tileSize,
+		Seed:      s.world.Seed,
+		Biome:     int32(s.world.Biome),
+	}, nil
+}
+
+// GetChunk streams the chunk at the coordinates and the chunks around it, nearest chunks first.
+func (s *Server) GetChunk(req *proto.GetChunkReq, stream proto.World_GetChunkServer) error {
+	if req.Radius < 0 || req.Radius > maxChunkRadius {
+		return status.Errorf(codes.InvalidArgument, "radius must be between 0 and %d", maxChunkRadius)
+	}
+
+	if _, err := s.world.chunk(int(req.X), int(req.Y)); err != nil {
+		return status.Error(codes.NotFound, "chunk not found")
+	}
+
+	var chunks []*Chunk
+	r := int(req.Radius)
+	for y := int(req.Y) - r; y <= int(req.Y)+r; y++ {
+		for x := int(req.X) - r; x <= int(req.X)+r; x++ {
+			if chunk, err := s.world.chunk(x, y); err == nil {
+				chunks = append(chunks, chunk)
+			}
+		}
+	}
+
+	center := &Chunk{X: int(req.X), Y: int(req.Y)}
+	sort.SliceStable(chunks, func(i, j int) bool {
+		return chunkDist(chunks[i], center) < chunkDist(chunks[j], center)
+	})
+
+	for _, chunk := range chunks {
+		if err := stream.Send(chunkToProto(chunk)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Return the number of chunks between the chunks, diagonal steps count as one.
+func chunkDist(a, b *Chunk) int {
+	dx, dy := a.X-b.X, a.Y-b.Y
+	if dx < 0 {
+		dx = -dx
+	}
+	if dy < 0 {
+		dy = -dy
+	}
+	if dx > dy {
+		return dx
+	}
+	return dy
+}
+
+func chunkToProto(chunk *Chunk) *proto.MapChunk {
+	tiles := make([]byte, len(chunk.Tiles))
+	for i, terrain := range chunk.Tiles {
+		tiles[i] = byte(terrain)
+	}
+
+	return &proto.MapChunk{X: int32(chunk.X), Y: int32(chunk.Y), Tiles: tiles}
+}
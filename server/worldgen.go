@@ -0,0 +1,194 @@
+package main
+
+import (
+	"math"
+)
+
+// BiomeParams tune the overworld generator. Noise values are between 0 and 1.
+type BiomeParams struct {
+	Version       int     // Saved with the seed, increase it whenever the params change
+	Scale         float64 // Width in tiles of the largest terrain features
+	Octaves       int     // Num layers of noise, each layer adds finer detail
+	Persistence   float64 // Strength of each layer compared to the one before it
+	Lacunarity    float64 // Detail of each layer compared to the one before it
+	HillLevel     float64 // Elevation at or above which tiles are hills
+	RockLevel     float64 // Elevation at or above which tiles are rock
+	CrystalScale  float64 // Width in tiles of crystal deposits
+	CrystalLevel  float64 // Crystal noise at or above which tiles that are not rock are crystal
+	Spawns        int     // Num spawn points
+	SpawnDistance float64 // Distance of the spawn points from the center as a fraction of half the map size
+	SpawnClearing int     // Radius in tiles of dirt cleared around each spawn point
+}
+
+// Biome used for generated overworlds.
+var defaultBiome = BiomeParams{
+	Version:       1,
+	Scale:         48,
+	Octaves:       4,
+	Persistence:   0.5,
+	Lacunarity:    2,
+	HillLevel:     0.55,
+	RockLevel:     0.68,
+	CrystalScale:  6,
+	CrystalLevel:  0.8,
+	Spawns:        8,
+	SpawnDistance: 0.5,
+	SpawnClearing: 3,
+}
+
+// Generate an overworld of the given size in chunks. The same seed and params always generate the same map.
+// Terrain comes from layered elevation noise, crystals from a second noise layer, and spawn points are placed
+// in a ring around the center. Rock is carved away where needed so every spawn point can reach every other.
+func generateTileMap(seed int64, width, height int, biome BiomeParams) *TileMap {
+	m := newTileMap(width, height)
+	m.Seed = seed
+	m.Biome = biome.Version
+
+	for y := 0; y < m.tileHeight(); y++ {
+		for x := 0; x < m.tileWidth(); x++ {
+			elevation := fractalNoise(seed, float64(x)/biome.Scale, float64(y)/biome.Scale, biome)
+			crystal := fractalNoise(seed+1, float64(x)/biome.CrystalScale, float64(y)/biome.CrystalScale, biome)
+
+			terrain := TerrainDirt
+			if elevation >= biome.RockLevel {
+				terrain = TerrainRock
+			} else if crystal >= biome.CrystalLevel {
+				terrain = TerrainCrystal
+			} else if elevation >= biome.HillLevel {
+				terrain = TerrainHill
+			}
+			m.setTile(x, y, terrain)
+		}
+	}
+
+	placeSpawns(m, biome)
+	connectSpawns(m)
+	return m
+}
+
+// Place the spawn points evenly around a ring at the center of the map and clear the ground around them.
+func placeSpawns(m *TileMap, biome BiomeParams) {
+	centerX, centerY := m.tileWidth()/2, m.tileHeight()/2
+	radius := biome.SpawnDistance * float64(min(centerX, centerY))
+
+	m.Spawns = make([]GridCoord, 0, biome.Spawns)
+	for i := 0; i < biome.Spawns; i++ {
+		angle := 2 * math.Pi * float64(i) / float64(biome.Spawns)
+		spawn := GridCoord{
+			X: centerX + int(math.Round(math.Cos(angle)*radius)),
+			Y: centerY + int(math.Round(math.Sin(angle)*radius)),
+		}
+		m.Spawns = append(m.Spawns, spawn)
+
+		r := biome.SpawnClearing
+		for y := spawn.Y - r; y <= spawn.Y+r; y++ {
+			for x := spawn.X - r; x <= spawn.X+r; x++ {
+				if (x-spawn.X)*(x-spawn.X)+(y-spawn.Y)*(y-spawn.Y) <= r*r {
+					m.setTile(x, y, TerrainDirt)
+				}
+			}
+		}
+	}
+}
+
+// Make sure every spawn point can walk to the first spawn point. Spawns that are walled off by rock
+// get a path carved to the first spawn point, rock on the path is turned into dirt.
+func connectSpawns(m *TileMap) {
+	if len(m.Spawns) < 2 {
+		return
+	}
+
+	first := m.Spawns[0]
+	reached := m.reachable(first)
+
+	for _, spawn := range m.Spawns[1:] {
+		if reached[spawn] {
+			continue
+		}
+
+		// Step towards the first spawn one axis at a time so the path is connected without diagonal moves
+		pos := spawn
+		for pos != first {
+			dx, dy := first.X-pos.X, first.Y-pos.Y
+			if abs(dx) >= abs(dy) {
+				pos.X += sign(dx)
+			} else {
+				pos.Y += sign(dy)
+			}
+
+			if terrain, _ := m.tile(pos.X, pos.Y); terrain == TerrainRock {
+				m.setTile(pos.X, pos.Y, TerrainDirt)
+			}
+		}
+
+		reached = m.reachable(first)
+	}
+}
+
+// Return every tile that can be walked to from the start, moving up, down, left or right.
+func (m *TileMap) reachable(start GridCoord) map[GridCoord]bool {
+	reached := map[GridCoord]bool{start: true}
+	queue := []GridCoord{start}
+
+	for len(queue) > 0 {
+		pos := queue[0]
+		queue = queue[1:]
+
+		for _, next := range []GridCoord{{X: pos.X + 1, Y: pos.Y}, {X: pos.X - 1, Y: pos.Y}, {X: pos.X, Y: pos.Y + 1}, {X: pos.X, Y: pos.Y - 1}} {
+			terrain, ok := m.tile(next.X, next.Y)
+			if ok && terrain.walkable() && !reached[next] {
+				reached[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return reached
+}
+
+// Return layered value noise at the point, between 0 and 1.
+func fractalNoise(seed int64, x, y float64, biome BiomeParams) float64 {
+	total, amplitude, frequency, max := 0.0, 1.0, 1.0, 0.0
+
+	for i := 0; i < biome.Octaves; i++ {
+		// Each layer uses its own seed so the layers do not line up
+		total += valueNoise(seed+int64(i)*7919, x*frequency, y*frequency) * amplitude
+		max += amplitude
+		amplitude *= biome.Persistence
+		frequency *= biome.Lacunarity
+	}
+
+	return total / max
+}
+
+// Return smoothly interpolated noise at the point, between 0 and 1.
+func valueNoise(seed int64, x, y float64) float64 {
+	x0, y0 := math.Floor(x), math.Floor(y)
+	ix, iy := int64(x0), int64(y0)
+
+	// Smoothstep so the noise has no creases at the lattice lines
+	tx, ty := x-x0, y-y0
+	tx = tx * tx * (3 - 2*tx)
+	ty = ty * ty * (3 - 2*ty)
+
+	top := lerp(latticeValue(seed, ix, iy), latticeValue(seed, ix+1, iy), tx)
+	bottom := lerp(latticeValue(seed, ix, iy+1), latticeValue(seed, ix+1, iy+1), tx)
+	return lerp(top, bottom, ty)
+}
+
+// Return a random value between 0 and 1 for the lattice point. The value only depends on the seed and the point,
+// so generation does not depend on the order tiles are generated in or on any shared random source.
+func latticeValue(seed, x, y int64) float64 {
+	h := uint64(seed)
+	h ^= uint64(x) * 0x9e3779b97f4a7c15
+	h ^= uint64(y) * 0xc2b2ae3d27d4eb4f
+
+	// SplitMix64 finalizer
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+
+	return float64(h>>11) / (1 << 53)
+}
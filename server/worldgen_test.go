@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// Biome with so much rock that most spawn points are walled off and need a path carved.
+var rockyBiome = func() BiomeParams {
+	biome := defaultBiome
+	biome.HillLevel = 0.4
+	biome.RockLevel = 0.45
+	return biome
+}()
+
+func TestGenerateTileMapDeterministic(t *testing.T) {
+	tests := []struct {
+		name          string
+		seed          int64
+		width, height int
+		biome         BiomeParams
+	}{
+		{"small", 1, 1, 1, defaultBiome},
+		{"not square", -7, 3, 2, defaultBiome},
+		{"rocky", 42, 4, 4, rockyBiome},
+		{"default size", 1234567890123, defaultMapChunks, defaultMapChunks, defaultBiome},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := generateTileMap(tt.seed, tt.width, tt.height, tt.biome)
+			b := generateTileMap(tt.seed, tt.width, tt.height, tt.biome)
+
+			if !reflect.DeepEqual(a, b) {
+				t.Error("generateTileMap() generated different maps from the same seed")
+			}
+			if a.checksum() != b.checksum() {
+				t.Errorf("checksum() = %v and %v for the same map", a.checksum(), b.checksum())
+			}
+
+			other := generateTileMap(tt.seed+1, tt.width, tt.height, tt.biome)
+			if reflect.DeepEqual(a.chunks, other.chunks) {
+				t.Error("generateTileMap() generated the same tiles from different seeds")
+			}
+		})
+	}
+}
+
+func TestGenerateTileMapSpawnsConnected(t *testing.T) {
+	tests := []struct {
+		name  string
+		size  int
+		biome BiomeParams
+	}{
+		{"default", defaultMapChunks, defaultBiome},
+		{"small", 2, defaultBiome},
+		{"rocky", 4, rockyBiome},
+	}
+
+	for _, tt := range tests {
+		for seed := int64(1); seed <= 3; seed++ {
+			t.Run(fmt.Sprintf("%v seed %v", tt.name, seed), func(t *testing.T) {
+				m := generateTileMap(seed, tt.size, tt.size, tt.biome)
+				if len(m.Spawns) != tt.biome.Spawns {
+					t.Fatalf("map has %v spawns, want %v", len(m.Spawns), tt.biome.Spawns)
+				}
+
+				// Walking works both ways, so every spawn reaching the first means every spawn reaches every other
+				reached := m.reachable(m.Spawns[0])
+				for _, spawn := range m.Spawns {
+					if terrain, ok := m.tile(spawn.X, spawn.Y); !ok || !terrain.walkable() {
+						t.Errorf("spawn %v is on %v, in map %v", spawn, terrain, ok)
+					} else if !reached[spawn] {
+						t.Errorf("spawn %v can not reach spawn %v", spawn, m.Spawns[0])
+					}
+				}
+			})
+		}
+	}
+}